@@ -67,6 +67,9 @@ var (
 		validator.NotBondedPoolName: {supply.Burner, supply.Staking},
 		swap.PoolName:               {supply.Minter, supply.Burner},
 		nft.ReservedPool:            {supply.Burner},
+		nft.MarketPool:              nil,
+		gov.ModuleName:              {supply.Burner},
+		validator.TreasuryPoolName:  nil,
 	}
 )
 
@@ -206,9 +209,10 @@ func NewInitApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		app.accountKeeper,
 		app.coinKeeper,
 		app.bankKeeper,
+		app.Router(),
 	)
 
-	app.nftKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey], app.supplyKeeper, validator.DefaultBondDenom)
+	app.nftKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey], app.supplyKeeper, app.bankKeeper, validator.DefaultBondDenom)
 
 	app.validatorKeeper = validator.NewKeeper(
 		app.cdc,
@@ -227,10 +231,12 @@ func NewInitApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		app.cdc,
 		app.keys[gov.StoreKey],
 		govSubspace,
+		app.paramsKeeper,
 		app.supplyKeeper,
 		&app.validatorKeeper,
 		govRouter,
 	)
+	app.setUpgradeHandlers()
 
 	app.swapKeeper = swap.NewKeeper(
 		app.cdc,
@@ -255,7 +261,7 @@ func NewInitApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	)
 
 	// app.mm.SetOrderBeginBlockers(coin.ModuleName, validator.ModuleName)
-	app.mm.SetOrderEndBlockers(validator.ModuleName, gov.ModuleName)
+	app.mm.SetOrderEndBlockers(validator.ModuleName, gov.ModuleName, multisig.ModuleName, nft.ModuleName)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils moodule must occur after staking so that pools are
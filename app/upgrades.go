@@ -0,0 +1,30 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/gov"
+)
+
+// Release150 is the release storing the NFTs individually and initializing the gov params. Its plan
+// is named by the location of the release binaries, e.g. "https://repo.decimalchain.com/1.5.0".
+const Release150 = "1.5.0"
+
+// setUpgradeHandlers registers the store migrations of the software upgrades in the gov keeper keyed
+// by the release of the plan. A migration runs once at the height its plan executes, so behavior
+// changes are shipped as migrations of the releases instead of new updates.UpdateNBlock heights.
+// Every release registers the handler of its plan, even one doing nothing, as the node without the
+// handler of the plan halts at its height.
+func (app *newApp) setUpgradeHandlers() {
+	app.govKeeper.SetUpgradeHandler(Release150, app.migrateRelease150)
+}
+
+// migrateRelease150 stores the NFTs individually with the indexes of their owners and creators,
+// indexes the multisig wallets and transactions and enables the rules kept in the gov params
+func (app *newApp) migrateRelease150(ctx sdk.Context, plan gov.Plan) error {
+	app.nftKeeper.MigrateNFTStore(ctx)
+	app.nftKeeper.MigrateCollectionInfos(ctx)
+	app.multisigKeeper.MigrateIndexes(ctx)
+	app.govKeeper.InitParams(ctx)
+	return nil
+}
@@ -34,6 +34,7 @@ import (
 	"bitbucket.org/decimalteam/go-node/config"
 	coinCmd "bitbucket.org/decimalteam/go-node/x/coin/client/cli"
 	multisigCmd "bitbucket.org/decimalteam/go-node/x/multisig/client/cli"
+	swapCmd "bitbucket.org/decimalteam/go-node/x/swap/client/cli"
 )
 
 func main() {
@@ -68,6 +69,8 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		flags.LineBreak,
+		swapCmd.GetRelayerCmd(cdc),
+		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		flags.LineBreak,
 		keys.Commands(),
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"bitbucket.org/decimalteam/go-node/app"
+	"bitbucket.org/decimalteam/go-node/config"
+	"bitbucket.org/decimalteam/go-node/x/gov/client/launcher"
+)
+
+const (
+	flagRoot         = "root"
+	flagSource       = "source"
+	flagOSArch       = "os-arch"
+	flagPollInterval = "poll-interval"
+)
+
+func main() {
+	cobra.EnableCommandSorting = false
+	rootCmd := &cobra.Command{
+		Use:   "decd-launcher [decd args...]",
+		Short: "Decimal Go Node launcher",
+		Long: "Run decd with the given arguments and upgrade it by the software upgrade plans.\n" +
+			"The node halts at the plan height, the launcher switches it to the binaries named by the plan and restarts it.\n\n" +
+			"Example:\n" +
+			"$ decd-launcher install ~/go/bin\n" +
+			"$ decd-launcher start",
+		Args:         cobra.ArbitraryArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+			l, err := newLauncher(cmd, logger)
+			if err != nil {
+				return err
+			}
+
+			node, err := cmd.Flags().GetString(flags.FlagNode)
+			if err != nil {
+				return err
+			}
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			pollInterval, err := cmd.Flags().GetDuration(flagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				sig := make(chan os.Signal, 1)
+				signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+				<-sig
+				cancel()
+			}()
+
+			logger.Info("node launcher started", "binary", l.Binary(config.NameFiles[0]))
+			return l.Run(ctx, client, args, pollInterval)
+		},
+	}
+	// arguments after the first one are passed to decd as they are
+	rootCmd.Flags().SetInterspersed(false)
+	rootCmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface of the node")
+	rootCmd.Flags().Duration(flagPollInterval, 10*time.Second, "How often the scheduled upgrade plan is queried to fetch its binaries ahead")
+
+	installCmd := &cobra.Command{
+		Use:   "install [bin-dir]",
+		Short: "Install decd and deccli from the directory as the binaries the node is started with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l, err := newLauncher(cmd, log.NewNopLogger())
+			if err != nil {
+				return err
+			}
+			return l.Install(args[0])
+		},
+	}
+
+	rootCmd.PersistentFlags().String(flagRoot, filepath.Join(app.DefaultNodeHome, "launcher"), "Directory with the binaries of the node")
+	rootCmd.PersistentFlags().String(flagSource, "", "Location used instead of the one named by the plan: an http(s) or file:// url or a local directory")
+	rootCmd.PersistentFlags().String(flagOSArch, launcher.OSArch(), "OS of the binaries fetched for the plan")
+	rootCmd.AddCommand(installCmd)
+
+	err := rootCmd.Execute()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func newLauncher(cmd *cobra.Command, logger log.Logger) (*launcher.Launcher, error) {
+	root, err := cmd.Flags().GetString(flagRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := cmd.Flags().GetString(flagSource)
+	if err != nil {
+		return nil, err
+	}
+
+	osArch, err := cmd.Flags().GetString(flagOSArch)
+	if err != nil {
+		return nil, err
+	}
+
+	return launcher.NewLauncher(root, source, osArch, config.UpdatesInfo, logger), nil
+}
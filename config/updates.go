@@ -9,8 +9,9 @@ import (
 
 type UpdatesInfoStruct struct {
 	filename  string
-	LastBlock int64            `json:"last_update"` // last height of 'software_upgrade'
-	AllBlocks map[string]int64 `json:"all_updates"` // map of executed upgrades. key - plan name, value - height
+	LastBlock int64            `json:"last_update"`     // last height of 'software_upgrade'
+	PrevBlock int64            `json:"previous_update"` // height of 'software_upgrade' before the last one
+	AllBlocks map[string]int64 `json:"all_updates"`     // map of executed upgrades. key - plan name, value - height
 }
 
 func NewUpdatesInfo(planfile string) *UpdatesInfoStruct {
@@ -22,15 +23,23 @@ func NewUpdatesInfo(planfile string) *UpdatesInfoStruct {
 }
 
 func (plan *UpdatesInfoStruct) PushNewPlanHeight(planHeight int64) {
+	if planHeight != plan.LastBlock {
+		plan.PrevBlock = plan.LastBlock
+	}
 	plan.LastBlock = planHeight
 }
 
+// PopPlanHeight restores the height pushed before the last one, e.g. when the last plan is cancelled
+func (plan *UpdatesInfoStruct) PopPlanHeight() {
+	plan.LastBlock = plan.PrevBlock
+}
+
 func (plan *UpdatesInfoStruct) AddExecutedPlan(planName string, planHeight int64) {
 	plan.AllBlocks[planName] = planHeight
 }
 
 func (plan *UpdatesInfoStruct) Save() error {
-	f, err := os.OpenFile(plan.filename, os.O_WRONLY|os.O_CREATE, 0644)
+	f, err := os.OpenFile(plan.filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return err
 	}
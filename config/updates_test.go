@@ -38,3 +38,13 @@ func TestSaveLoad(t *testing.T) {
 	assert.Equal(t, updInf.AllBlocks, newinf.AllBlocks, "AllBlocks must be same")
 	assert.Equal(t, updInf.LastBlock, newinf.LastBlock, "LastBlock must be same")
 }
+
+func TestPopPlanHeight(t *testing.T) {
+	updInf := NewUpdatesInfo("")
+	updInf.PushNewPlanHeight(100)
+	updInf.PushNewPlanHeight(100)
+	updInf.PushNewPlanHeight(200)
+	assert.Equal(t, int64(200), updInf.LastBlock, "LastBlock must be pushed")
+	updInf.PopPlanHeight()
+	assert.Equal(t, int64(100), updInf.LastBlock, "LastBlock must be restored")
+}
@@ -265,6 +265,13 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 
 	msgs := tx.GetMsgs()
 	for _, msg := range msgs {
+		// The message wrapped into the universal multisig transaction is charged as if it is sent
+		// by itself, the multisig transaction fee is added to it
+		universal, isUniversal := msg.(multisig.MsgCreateUniversalTransaction)
+		if isUniversal {
+			msg = universal.Message
+		}
+
 		switch msg.Type() {
 		case validator.DeclareCandidateConst:
 			commissionInBaseCoin = commissionInBaseCoin.AddRaw(declareCandidateFee)
@@ -356,6 +363,10 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 				commissionInBaseCoin = commissionInBaseCoin.AddRaw(htltFee)
 			}
 		}
+
+		if isUniversal {
+			commissionInBaseCoin = commissionInBaseCoin.AddRaw(createTransactionFee)
+		}
 	}
 
 	commissionInBaseCoin = helpers.UnitToPip(commissionInBaseCoin)
@@ -163,7 +163,7 @@ func NewSimApp(
 		auth.FeeCollectorName,
 	)
 	app.NFTKeeper = nft.NewKeeper(
-		app.cdc, keys[nft.StoreKey], app.SupplyKeeper, validator.DefaultBondDenom)
+		app.cdc, keys[nft.StoreKey], app.SupplyKeeper, app.BankKeeper, validator.DefaultBondDenom)
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
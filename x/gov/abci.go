@@ -2,23 +2,14 @@ package gov
 
 import (
 	"fmt"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"net/url"
 	"os"
-	"strings"
+	"strconv"
 
-	ncfg "bitbucket.org/decimalteam/go-node/config"
 	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var (
-	downloadStat = make(map[string]bool)
-)
-
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	// Migrate state to updated prefixes if necessary
 	if !k.IsMigratedToUpdatedPrefixes(ctx) {
@@ -34,64 +25,13 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		return
 	}
 
+	// The node runs the binaries of the plan since the plan height
 	if ctx.BlockHeight() > plan.Height {
-		nextVersion := loadVersion(plan.Name)
-		if ncfg.DecimalVersion != nextVersion {
-			ctx.Logger().Error(fmt.Sprintf("failed upgrade \"%s\" at height %d with version", plan.Name, plan.Height))
-			os.Exit(4)
-		}
 		k.ClearUpgradePlan(ctx)
 		return
 	}
 
-	allBlocks := ncfg.UpdatesInfo.AllBlocks
-	if _, ok := allBlocks[plan.Name]; ok {
-		return
-	}
-
-	_, ok := downloadStat[plan.Name]
-
-	if ctx.BlockHeight() > (plan.Height-plan.ToDownload) && ctx.BlockHeight() < plan.Height && !ok {
-		//get info to check hash
-		mapping := plan.Mapping()
-		if mapping == nil {
-			ctx.Logger().Error("error: plan mapping decode")
-			return
-		}
-		hashes, ok := mapping[k.OSArch()]
-		if !ok {
-			ctx.Logger().Error(fmt.Sprintf("error: plan mapping[os] for '%s' undefined", k.OSArch()))
-			return
-		}
-		/* NOTE:
-		checksum generator saves files' hashes as array in order 1)decd 2)deccli
-		ncfg.NameFiles must be []string{"decd", "deccli"}
-		*/
-		for i, name := range ncfg.NameFiles {
-			// example:
-			// from "http://127.0.0.1/95000/decd"
-			// to "http://127.0.0.1/95000/linux/ubuntu/20.04/decd"
-			newUrl := k.GenerateUrl(fmt.Sprintf("%s/%s", plan.Name, name))
-			if newUrl == "" {
-				ctx.Logger().Error("error: failed with generate url")
-				return
-			}
-
-			if !k.UrlPageExist(newUrl) {
-				ctx.Logger().Error("error: url page is not exists")
-				return
-			}
-
-			downloadStat[plan.Name] = true
-			downloadName := k.GetDownloadName(name)
-
-			if _, err := os.Stat(downloadName); os.IsNotExist(err) {
-				go k.DownloadAndCheckHash(ctx, downloadName, newUrl, hashes[i])
-			}
-		}
-	}
-
-	// To make sure clear upgrade is executed at./de the same block
+	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(ctx) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
 		if k.IsSkipHeight(ctx.BlockHeight()) {
@@ -103,32 +43,25 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 			return
 		}
 
-		// We have an upgrade handler for this upgrade name, so apply the upgrade
-		ctx.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
-		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
-
-		err := k.ApplyUpgrade(ctx, plan)
-		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("upgrade \"%s\" with '%s'", plan.Name, err.Error()))
-			os.Exit(1)
-		}
-
-		ncfg.UpdatesInfo.PushNewPlanHeight(plan.Height)
-		err = ncfg.UpdatesInfo.Save()
-		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("push \"%s\" with error: %s", plan.Name, err.Error()))
-			os.Exit(2)
-		}
+		// The node having the upgrade handler of the plan runs the binaries of the plan
+		// and applies the plan at its height, the release without store migrations
+		// registers a handler doing nothing
+		if k.HasUpgradeHandler(plan) {
+			ctx.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+			ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
 
-		ncfg.UpdatesInfo.AddExecutedPlan(plan.Name, plan.Height)
-		err = ncfg.UpdatesInfo.Save()
-		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("save \"%s\" with '%s'", plan.Name, err.Error()))
-			os.Exit(3)
+			err := k.ApplyUpgrade(ctx, plan)
+			if err != nil {
+				panic(fmt.Sprintf("upgrade \"%s\" with '%s'", plan.Name, err.Error()))
+			}
+			return
 		}
 
-		ctx.Logger().Info(fmt.Sprintf("success upgrade \"%s\"", plan.Name))
-		os.Exit(0)
+		// The node only halts, the launcher detects the message in the node output, fetches the
+		// binaries of the plan, switches to them and restarts the node
+		upgradeMsg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
+		ctx.Logger().Error(upgradeMsg)
+		panic(upgradeMsg)
 	}
 }
 
@@ -142,7 +75,26 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 	// delete inactive proposal from store
 	keeper.IterateAllInactiveProposalsQueue(ctx, func(proposal Proposal) bool {
-		if ctx.BlockHeight() == int64(proposal.VotingStartBlock) {
+		if ctx.BlockHeight() == int64(proposal.VotingStartBlock) && proposal.Status == StatusDepositPeriod {
+			// the proposal didn't reach the minimum deposit in time
+			keeper.DeleteProposal(ctx, proposal.ProposalID)
+			keeper.DeleteDeposits(ctx, proposal.ProposalID)
+
+			logger.Info(
+				fmt.Sprintf(
+					"proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
+					proposal.ProposalID, proposal.GetTitle(), keeper.GetDepositParams(ctx).MinDeposit, proposal.TotalDeposit,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeInactiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				),
+			)
+		} else if ctx.BlockHeight() == int64(proposal.VotingStartBlock) {
 			keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingStartBlock)
 			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndBlock)
 			proposal.Status = StatusVotingPeriod
@@ -164,12 +116,30 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		if int64(proposal.VotingEndBlock) == ctx.BlockHeight() {
 			var tagValue, logMsg string
 
-			passes, tallyResults, totalVotingPower := keeper.Tally(ctx, proposal)
+			passes, burnDeposits, tallyResults, totalVotingPower := keeper.Tally(ctx, proposal)
+
+			if burnDeposits {
+				keeper.DeleteDeposits(ctx, proposal.ProposalID)
+			} else {
+				keeper.RefundDeposits(ctx, proposal.ProposalID)
+			}
 
 			if passes {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
+
+				if len(proposal.Changes) > 0 || len(proposal.Spends) > 0 {
+					err := executeProposal(ctx, keeper, proposal)
+					if err != nil {
+						proposal.Status = StatusFailed
+						tagValue = types.AttributeValueProposalFailed
+						logMsg = fmt.Sprintf("passed, but %s", err)
+					} else {
+						emitParamChangeEvents(ctx, proposal)
+						emitCommunitySpendEvents(ctx, proposal)
+					}
+				}
 			} else {
 				proposal.Status = StatusRejected
 				tagValue = types.AttributeValueProposalRejected
@@ -197,6 +167,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 					sdk.NewAttribute(types.AttributeKeyResultVoteAbstain, tallyResults.Abstain.String()),
 					sdk.NewAttribute(types.AttributeKeyResultVoteNo, tallyResults.No.String()),
 					sdk.NewAttribute(types.AttributeKeyTotalVotingPower, totalVotingPower.String()),
+					sdk.NewAttribute(types.AttributeKeyDepositsBurned, strconv.FormatBool(burnDeposits)),
 				),
 			)
 		}
@@ -205,31 +176,48 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	})
 }
 
-func loadVersion(urlPath string) string {
-	const fileVersion = "version.txt"
+// executeProposal applies the param changes and makes the community spends of a passed proposal,
+// either all of them or none
+func executeProposal(ctx sdk.Context, keeper Keeper, proposal Proposal) error {
+	cacheCtx, writeCache := ctx.CacheContext()
 
-	// example: "version.txt"
-	u, err := url.Parse(fileVersion)
+	err := keeper.ApplyParamChanges(cacheCtx, proposal.Changes)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("failed to change params: %w", err)
 	}
 
-	// example: "https://testnet-repo.decimalchain.com/95000"
-	base, err := u.Parse(urlPath)
+	err = keeper.SpendFromTreasury(cacheCtx, proposal.ProposalID, proposal.Spends)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("failed to spend from the treasury: %w", err)
 	}
 
-	// result: "https://testnet-repo.decimalchain.com/version.txt"
-	resp, err := http.Get(base.ResolveReference(u).String())
-	if err != nil {
-		log.Fatalln(err)
-	}
+	writeCache()
+	return nil
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Fatalln(err)
+func emitCommunitySpendEvents(ctx sdk.Context, proposal Proposal) {
+	for _, spend := range proposal.Spends {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommunitySpend,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, spend.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, spend.Amount.String()),
+			),
+		)
 	}
+}
 
-	return strings.TrimSpace(string(body))
+func emitParamChangeEvents(ctx sdk.Context, proposal Proposal) {
+	for _, change := range proposal.Changes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChange,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyParamSubspace, change.Subspace),
+				sdk.NewAttribute(types.AttributeKeyParamKey, change.Key),
+				sdk.NewAttribute(types.AttributeKeyParamValue, change.Value),
+			),
+		)
+	}
 }
@@ -1,16 +1,31 @@
 package gov
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	ncfg "bitbucket.org/decimalteam/go-node/config"
 	"bitbucket.org/decimalteam/go-node/utils/updates"
 	keep "bitbucket.org/decimalteam/go-node/x/gov/internal/keeper"
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	"bitbucket.org/decimalteam/go-node/x/validator"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
+// legacyGenesisState returns the genesis state exported before the gov params were initialized
+func legacyGenesisState() GenesisState {
+	genesisState := types.DefaultGenesisState()
+	genesisState.DepositParams = types.DepositParams{}
+	return genesisState
+}
+
 func TestTickPassedVotingPeriod(t *testing.T) {
 	input := getTestInput(t, 10, GenesisState{}, nil)
 	SortAddresses(input.addrs)
@@ -267,3 +282,666 @@ func TestEndBlockerProposalRejected3(t *testing.T) {
 	require.Equal(t, validator.TokensFromConsensusPower(10), proposal.FinalTallyResult.Abstain)
 	require.Equal(t, sdk.ZeroInt(), proposal.FinalTallyResult.No)
 }
+
+func TestParamChangeProposal(t *testing.T) {
+	input := getTestInput(t, 1, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	proposer, err := sdk.AccAddressFromBech32(validator.DAOAddress1)
+	require.NoError(t, err)
+	submit := func(changes ...ParamChange) (*sdk.Result, error) {
+		content := types.Content{Title: "title", Description: "desc", Changes: changes}
+		return govHandler(ctx, NewMsgSubmitProposal(content, proposer, uint64(ctx.BlockHeight())+5, uint64(ctx.BlockHeight())+10))
+	}
+
+	// changes are validated by the params subspaces at submit time
+	_, err = submit(NewParamChange("unknown", "MaxValidators", "20"))
+	require.Error(t, err)
+	_, err = submit(NewParamChange(validator.DefaultParamSpace, "Unknown", "20"))
+	require.Error(t, err)
+	_, err = submit(NewParamChange(validator.DefaultParamSpace, "MaxValidators", "0"))
+	require.Error(t, err)
+	_, err = submit(NewParamChange(DefaultParamspace, "tallyparams", `{"quorum":"0.5","threshold":"2"}`))
+	require.Error(t, err)
+
+	res, err := submit(
+		NewParamChange(validator.DefaultParamSpace, "MaxValidators", "20"),
+		NewParamChange(DefaultParamspace, "tallyparams", `{"quorum":"0.5","threshold":"0.5"}`),
+	)
+	require.NoError(t, err)
+	proposal, ok := input.keeper.GetProposal(ctx, types.GetProposalIDFromBytes(res.Data))
+	require.True(t, ok)
+
+	// a proposal which changes can not be applied anymore fails
+	failing, err := input.keeper.SubmitProposal(ctx, types.Content{
+		Title:       "title",
+		Description: "desc",
+		Changes: []ParamChange{
+			NewParamChange(validator.DefaultParamSpace, "KeyMaxEntries", "3"),
+			NewParamChange(validator.DefaultParamSpace, "MaxValidators", "0"),
+		},
+	}, proposal.VotingStartBlock, proposal.VotingEndBlock)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingStartBlock))
+	EndBlocker(ctx, input.keeper)
+
+	for _, id := range []uint64{proposal.ProposalID, failing.ProposalID} {
+		err = input.keeper.AddVote(ctx, id, sdk.ValAddress(input.addrs[0]), types.OptionYes)
+		require.NoError(t, err)
+	}
+
+	maxEntries := input.vk.MaxEntries(ctx)
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingEndBlock)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Equal(t, uint16(20), input.vk.MaxValidators(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), input.keeper.GetTallyParams(ctx).Quorum)
+
+	failing, ok = input.keeper.GetProposal(ctx, failing.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, failing.Status)
+	require.Equal(t, maxEntries, input.vk.MaxEntries(ctx))
+
+	changeEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeParamChange {
+			changeEvents++
+		}
+	}
+	require.Equal(t, 2, changeEvents)
+}
+
+func TestDepositProposal(t *testing.T) {
+	input := getTestInput(t, 4, legacyGenesisState(), nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}, []int64{10, 10})
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	deposit := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(validator.DefaultBondDenom, validator.TokensFromConsensusPower(amount)))
+	}
+
+	proposer, depositor := input.addrs[2], input.addrs[3]
+	start, end := uint64(ctx.BlockHeight())+10, uint64(ctx.BlockHeight())+20
+	submit := func(ctx sdk.Context, start, end uint64, initialDeposit sdk.Coins) (Proposal, error) {
+		content := types.Content{Title: "title", Description: "desc"}
+		res, err := govHandler(ctx, NewMsgSubmitProposal(content, proposer, start, end).WithInitialDeposit(initialDeposit))
+		if err != nil {
+			return Proposal{}, err
+		}
+		proposal, ok := input.keeper.GetProposal(ctx, types.GetProposalIDFromBytes(res.Data))
+		require.True(t, ok)
+		return proposal, nil
+	}
+
+	// only whitelisted proposers can submit proposals until the deposit params are set
+	_, err := submit(ctx, start, end, nil)
+	require.Error(t, err)
+
+	input.keeper.SetDepositParams(ctx, NewDepositParams(deposit(100), 100, []string{validator.DAOAddress1}))
+
+	// the voting has to start within the maximum deposit period
+	_, err = submit(ctx, start+100, end+100, deposit(50))
+	require.Error(t, err)
+	// deposits must be paid in coins of the minimum deposit
+	_, err = submit(ctx, start, end, sdk.NewCoins(sdk.NewCoin("other", sdk.NewInt(1))))
+	require.Error(t, err)
+
+	vetoed, err := submit(ctx, start, end, deposit(50))
+	require.NoError(t, err)
+	require.Equal(t, StatusDepositPeriod, vetoed.Status)
+
+	_, err = govHandler(ctx, NewMsgDeposit(depositor, vetoed.ProposalID, deposit(50)))
+	require.NoError(t, err)
+	vetoed, _ = input.keeper.GetProposal(ctx, vetoed.ProposalID)
+	require.Equal(t, StatusWaiting, vetoed.Status)
+	require.Equal(t, deposit(100), vetoed.TotalDeposit)
+	require.Len(t, input.keeper.GetDeposits(ctx, vetoed.ProposalID), 2)
+
+	rejected, err := submit(ctx, start, end, deposit(100))
+	require.NoError(t, err)
+	require.Equal(t, StatusWaiting, rejected.Status)
+
+	dropped, err := submit(ctx, start, end, deposit(10))
+	require.NoError(t, err)
+	require.Equal(t, StatusDepositPeriod, dropped.Status)
+
+	moduleCoins := func() sdk.Coins {
+		return input.sk.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+	}
+	require.Equal(t, deposit(210), moduleCoins())
+	totalSupply := input.sk.GetSupply(ctx).GetTotal()
+
+	// a proposal without the minimum deposit is deleted and its deposits are burned
+	ctx = ctx.WithBlockHeight(int64(start))
+	EndBlocker(ctx, input.keeper)
+	_, ok := input.keeper.GetProposal(ctx, dropped.ProposalID)
+	require.False(t, ok)
+	require.Empty(t, input.keeper.GetDeposits(ctx, dropped.ProposalID))
+	require.Equal(t, deposit(200), moduleCoins())
+	require.Equal(t, totalSupply.Sub(deposit(10)), input.sk.GetSupply(ctx).GetTotal())
+
+	_, err = govHandler(ctx, NewMsgDeposit(depositor, vetoed.ProposalID, deposit(50)))
+	require.Error(t, err)
+
+	require.NoError(t, input.keeper.AddVote(ctx, vetoed.ProposalID, sdk.ValAddress(input.addrs[0]), types.OptionNoWithVeto))
+	require.NoError(t, input.keeper.AddVote(ctx, vetoed.ProposalID, sdk.ValAddress(input.addrs[1]), types.OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, rejected.ProposalID, sdk.ValAddress(input.addrs[0]), types.OptionNo))
+	require.NoError(t, input.keeper.AddVote(ctx, rejected.ProposalID, sdk.ValAddress(input.addrs[1]), types.OptionYes))
+
+	// deposits of a vetoed proposal are burned, other deposits are refunded
+	ctx = ctx.WithBlockHeight(int64(end))
+	EndBlocker(ctx, input.keeper)
+
+	vetoed, _ = input.keeper.GetProposal(ctx, vetoed.ProposalID)
+	require.Equal(t, StatusRejected, vetoed.Status)
+	rejected, _ = input.keeper.GetProposal(ctx, rejected.ProposalID)
+	require.Equal(t, StatusRejected, rejected.Status)
+
+	require.Empty(t, input.keeper.GetAllDeposits(ctx))
+	require.True(t, moduleCoins().Empty())
+	require.Equal(t, totalSupply.Sub(deposit(110)), input.sk.GetSupply(ctx).GetTotal())
+}
+
+func TestDelegatorVoteOverride(t *testing.T) {
+	input := getTestInput(t, 4, legacyGenesisState(), nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	val1, val2 := sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])
+	delegator, stranger := input.addrs[2], input.addrs[3]
+
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{val1, val2}, []int64{10, 10})
+	_, err := validatorHandler(ctx, validator.NewMsgDelegate(val1, delegator, sdk.NewCoin(validator.DefaultBondDenom, validator.TokensFromConsensusPower(4))))
+	require.NoError(t, err)
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	content := types.Content{Title: "title", Description: "desc"}
+	proposal, err := input.keeper.SubmitProposal(ctx, content, uint64(ctx.BlockHeight())+10, uint64(ctx.BlockHeight())+20)
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// only validators can vote until the params are initialized
+	_, err = govHandler(ctx, types.NewMsgVote(sdk.ValAddress(delegator), proposal.ProposalID, types.OptionNo))
+	require.Error(t, err)
+
+	input.keeper.InitParams(ctx)
+	// accounts without delegations to the counted validators can't vote
+	_, err = govHandler(ctx, types.NewMsgVote(sdk.ValAddress(stranger), proposal.ProposalID, types.OptionNo))
+	require.Error(t, err)
+
+	_, err = govHandler(ctx, types.NewMsgVote(val1, proposal.ProposalID, types.OptionYes))
+	require.NoError(t, err)
+	_, err = govHandler(ctx, types.NewMsgVote(val2, proposal.ProposalID, types.OptionNo))
+	require.NoError(t, err)
+	_, err = govHandler(ctx, types.NewMsgVote(sdk.ValAddress(delegator), proposal.ProposalID, types.OptionNo))
+	require.NoError(t, err)
+
+	// the stake of the delegator is moved from its validator to its own option
+	breakdown := input.keeper.VoteBreakdown(ctx, proposal)
+	require.Len(t, breakdown.Validators, 2)
+	require.Equal(t, val1, breakdown.Validators[0].Validator)
+	require.Equal(t, validator.TokensFromConsensusPower(10), breakdown.Validators[0].Power)
+	require.Equal(t, validator.TokensFromConsensusPower(4), breakdown.Validators[0].Deductions)
+	require.Equal(t, validator.TokensFromConsensusPower(10), breakdown.Validators[1].Power)
+	require.True(t, breakdown.Validators[1].Deductions.IsZero())
+	require.Len(t, breakdown.Delegators, 1)
+	require.Equal(t, delegator, breakdown.Delegators[0].Delegator)
+	require.Equal(t, validator.TokensFromConsensusPower(4), breakdown.Delegators[0].Power)
+
+	passes, _, tallyResults, totalVotingPower := input.keeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.Equal(t, validator.TokensFromConsensusPower(10), tallyResults.Yes)
+	require.Equal(t, validator.TokensFromConsensusPower(14), tallyResults.No)
+	require.Equal(t, validator.TokensFromConsensusPower(24).ToDec(), totalVotingPower)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposal.ProposalID))
+}
+
+func TestTallyParamsVoterSet(t *testing.T) {
+	input := getTestInput(t, 3, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	val1, val2, val3 := sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1]), sdk.ValAddress(input.addrs[2])
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{val1, val2, val3}, []int64{30, 20, 10})
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	tallyParams := input.keeper.GetTallyParams(ctx)
+	tallyParams.MaxValidators = 2
+	tallyParams.NonVotersAbstain = false
+	input.keeper.SetTallyParams(ctx, tallyParams)
+
+	content := types.Content{Title: "title", Description: "desc"}
+	proposal, err := input.keeper.SubmitProposal(ctx, content, uint64(ctx.BlockHeight())+10, uint64(ctx.BlockHeight())+20)
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// only the validators of the voter set can vote
+	_, err = govHandler(ctx, types.NewMsgVote(val3, proposal.ProposalID, types.OptionNo))
+	require.Error(t, err)
+
+	// a vote can be changed until the voting ends
+	_, err = govHandler(ctx, types.NewMsgVote(val1, proposal.ProposalID, types.OptionNo))
+	require.NoError(t, err)
+	res, err := govHandler(ctx, types.NewMsgVote(val1, proposal.ProposalID, types.OptionYes))
+	require.NoError(t, err)
+	changed := false
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			changed = changed || string(attr.Key) == types.AttributeKeyPreviousOption
+		}
+	}
+	require.True(t, changed)
+	vote, found := input.keeper.GetVote(ctx, proposal.ProposalID, val1)
+	require.True(t, found)
+	require.Equal(t, types.OptionYes, vote.Option)
+
+	_, err = govHandler(ctx.WithBlockHeight(int64(proposal.VotingEndBlock)), types.NewMsgVote(val1, proposal.ProposalID, types.OptionNo))
+	require.Error(t, err)
+
+	// validators outside of the voter set and non-voters are not counted
+	passes, _, tallyResults, totalVotingPower := input.keeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, validator.TokensFromConsensusPower(30), tallyResults.Yes)
+	require.True(t, tallyResults.Abstain.IsZero())
+	require.Equal(t, validator.TokensFromConsensusPower(30).ToDec(), totalVotingPower)
+	require.Equal(t, []sdk.ValAddress{val1, val2}, tallyResults.Validators)
+}
+
+func TestCommunitySpendProposal(t *testing.T) {
+	input := getTestInput(t, 2, legacyGenesisState(), nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+
+	// the treasury receives its share of the block rewards
+	validatorParams := input.vk.GetParams(ctx)
+	validatorParams.TreasuryCommission = sdk.NewDecWithPrec(1, 1)
+	input.vk.SetParams(ctx, validatorParams)
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	balance := input.keeper.GetTreasuryBalance(ctx)
+	require.True(t, balance.IsAllPositive())
+
+	proposer, err := sdk.AccAddressFromBech32(validator.DAOAddress1)
+	require.NoError(t, err)
+	recipient := input.addrs[1]
+	spend := NewCommunitySpend(recipient, sdk.NewCoins(sdk.NewCoin(validator.DefaultBondDenom, balance.AmountOf(validator.DefaultBondDenom).QuoRaw(2))))
+	submit := func(ctx sdk.Context, spends ...CommunitySpend) (*sdk.Result, error) {
+		content := types.Content{Title: "title", Description: "desc", Spends: spends}
+		return govHandler(ctx, NewMsgSubmitProposal(content, proposer, uint64(ctx.BlockHeight())+5, uint64(ctx.BlockHeight())+10))
+	}
+
+	// community spends are enabled once the params are initialized
+	_, err = submit(ctx, spend)
+	require.Error(t, err)
+	input.keeper.InitParams(ctx)
+	content := types.Content{Title: "title", Description: "desc", Spends: []CommunitySpend{NewCommunitySpend(recipient, sdk.Coins{})}}
+	require.Error(t, NewMsgSubmitProposal(content, proposer, 1, 2).ValidateBasic())
+
+	res, err := submit(ctx, spend)
+	require.NoError(t, err)
+	proposal, ok := input.keeper.GetProposal(ctx, types.GetProposalIDFromBytes(res.Data))
+	require.True(t, ok)
+
+	// a proposal spending more than the treasury balance fails
+	res, err = submit(ctx, spend, spend, spend)
+	require.NoError(t, err)
+	failing, ok := input.keeper.GetProposal(ctx, types.GetProposalIDFromBytes(res.Data))
+	require.True(t, ok)
+
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingStartBlock))
+	EndBlocker(ctx, input.keeper)
+
+	for _, id := range []uint64{proposal.ProposalID, failing.ProposalID} {
+		err = input.keeper.AddVote(ctx, id, sdk.ValAddress(input.addrs[0]), types.OptionYes)
+		require.NoError(t, err)
+	}
+
+	recipientCoins := input.ck.AccountKeeper.GetAccount(ctx, recipient).GetCoins()
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingEndBlock))
+	EndBlocker(ctx, input.keeper)
+
+	proposal, _ = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.Equal(t, StatusPassed, proposal.Status)
+	failing, _ = input.keeper.GetProposal(ctx, failing.ProposalID)
+	require.Equal(t, StatusFailed, failing.Status)
+
+	require.Equal(t, balance.Sub(spend.Amount), input.keeper.GetTreasuryBalance(ctx))
+	require.Equal(t, recipientCoins.Add(spend.Amount...), input.ck.AccountKeeper.GetAccount(ctx, recipient).GetCoins())
+	require.Equal(t, TreasurySpends{types.NewTreasurySpend(proposal.ProposalID, ctx.BlockHeight(), []CommunitySpend{spend})}, input.keeper.GetTreasurySpends(ctx))
+}
+
+func TestCancelReplaceUpgradeProposal(t *testing.T) {
+	input := getTestInput(t, 1, legacyGenesisState(), nil)
+	govHandler := NewHandler(input.keeper)
+
+	// keep the node updates info out of the home directory
+	dataPath, err := ioutil.TempDir("", "gotest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataPath)
+	updatesInfo := ncfg.UpdatesInfo
+	ncfg.UpdatesInfo = ncfg.NewUpdatesInfo(filepath.Join(dataPath, ncfg.UpdatesName))
+	defer func() { ncfg.UpdatesInfo = updatesInfo }()
+
+	proposer, err := sdk.AccAddressFromBech32(types.AddressForSoftwareUpgrade)
+	require.NoError(t, err)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+	plan := Plan{Name: "https://repo.decimalchain.com/7700100", Height: ctx.BlockHeight() + 100, ToDownload: 10}
+
+	// only the upgrade authority cancels and replaces upgrades
+	require.Error(t, NewCancelSoftwareUpgradeProposal("title", "desc", input.addrs[0]).ValidateBasic())
+	require.Error(t, NewReplaceSoftwareUpgradeProposal("title", "desc", plan, input.addrs[0]).ValidateBasic())
+
+	// cancelling and replacing upgrades are enabled once the params are initialized
+	_, err = govHandler(ctx, NewCancelSoftwareUpgradeProposal("title", "desc", proposer))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not enabled yet")
+	_, err = govHandler(ctx, NewReplaceSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not enabled yet")
+	input.keeper.InitParams(ctx)
+
+	// nothing to cancel or replace before an upgrade is scheduled
+	_, err = govHandler(ctx, NewCancelSoftwareUpgradeProposal("title", "desc", proposer))
+	require.Error(t, err)
+	_, err = govHandler(ctx, NewReplaceSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.Error(t, err)
+
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.NoError(t, err)
+
+	// reschedule the same release to a later height
+	newPlan := plan
+	newPlan.Height += 100
+	res, err := govHandler(ctx, NewReplaceSoftwareUpgradeProposal("title", "desc", newPlan, proposer))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeReplaceUpgrade, res.Events[len(res.Events)-1].Type)
+
+	current, found := input.keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, newPlan, current)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	res, err = govHandler(ctx, NewCancelSoftwareUpgradeProposal("title", "desc", proposer))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeCancelUpgrade, res.Events[len(res.Events)-1].Type)
+
+	_, found = input.keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	require.Equal(t, CancelledPlans{
+		types.NewCancelledPlan(plan, updates.Update13Block, newPlan.Name),
+		types.NewCancelledPlan(newPlan, updates.Update13Block+1, ""),
+	}, input.keeper.GetCancelledPlans(ctx))
+
+	// the cancelled plan doesn't start the grace period of slashing
+	require.Equal(t, int64(0), ncfg.UpdatesInfo.LastBlock)
+}
+
+func TestReleaseManifestUpgradeProposal(t *testing.T) {
+	input := getTestInput(t, 1, GenesisState{}, nil)
+	govHandler := NewHandler(input.keeper)
+
+	// keep the node updates info out of the home directory
+	dataPath, err := ioutil.TempDir("", "gotest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataPath)
+	updatesInfo := ncfg.UpdatesInfo
+	ncfg.UpdatesInfo = ncfg.NewUpdatesInfo(filepath.Join(dataPath, ncfg.UpdatesName))
+	defer func() { ncfg.UpdatesInfo = updatesInfo }()
+
+	proposer, err := sdk.AccAddressFromBech32(types.AddressForSoftwareUpgrade)
+	require.NoError(t, err)
+
+	releaseKeys := []ed25519.PrivKeyEd25519{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	var keys []string
+	for _, privKey := range releaseKeys {
+		pubKey := privKey.PubKey().(ed25519.PubKeyEd25519)
+		keys = append(keys, hex.EncodeToString(pubKey[:]))
+	}
+	input.keeper.SetReleaseParams(input.ctx, NewReleaseParams(keys, 2))
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+	plan := Plan{Name: "https://repo.decimalchain.com/7700100", Height: ctx.BlockHeight() + 100, ToDownload: 10}
+
+	hash := "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+	manifest := NewReleaseManifest(plan.Name, []ReleaseBinary{
+		{File: "decd", OSArch: "linux/ubuntu/20.04", Hash: hash},
+		{File: "deccli", OSArch: "linux/ubuntu/20.04", Hash: hash},
+	})
+	require.NoError(t, manifest.Sign(releaseKeys[0]))
+	// a signature by an unknown key is not counted
+	require.NoError(t, manifest.Sign(ed25519.GenPrivKey()))
+	require.Error(t, manifest.Verify(keys, 2))
+
+	withManifest := func(plan Plan, manifest ReleaseManifest) Plan {
+		bz, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		plan.Info = string(bz)
+		return plan
+	}
+
+	// plans without a manifest or signed by too few release keys are rejected
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.Error(t, err)
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", withManifest(plan, manifest), proposer))
+	require.Error(t, err)
+
+	require.NoError(t, manifest.Sign(releaseKeys[2]))
+	require.NoError(t, manifest.Verify(keys, 2))
+
+	// the manifest is bound to the plan name
+	otherPlan := plan
+	otherPlan.Name = "https://repo.decimalchain.com/7700200"
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", withManifest(otherPlan, manifest), proposer))
+	require.Error(t, err)
+
+	// a manifest changed after signing is rejected
+	tampered := manifest
+	tampered.Binaries = []ReleaseBinary{manifest.Binaries[0]}
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", withManifest(plan, tampered), proposer))
+	require.Error(t, err)
+
+	// plans are not checked before the params are initialized
+	legacyInput := getTestInput(t, 1, legacyGenesisState(), nil)
+	legacyInput.keeper.SetReleaseParams(legacyInput.ctx, NewReleaseParams(keys, 2))
+	_, err = NewHandler(legacyInput.keeper)(legacyInput.ctx.WithBlockHeight(ctx.BlockHeight()), types.NewSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.NoError(t, err)
+
+	plan = withManifest(plan, manifest)
+	_, err = govHandler(ctx, types.NewSoftwareUpgradeProposal("title", "desc", plan, proposer))
+	require.NoError(t, err)
+
+	hashes, ok := plan.Hashes("linux/ubuntu/20.04", ncfg.NameFiles)
+	require.True(t, ok)
+	require.Equal(t, []string{hash, hash}, hashes)
+	_, ok = plan.Hashes("linux/centos/7", ncfg.NameFiles)
+	require.False(t, ok)
+
+	// replacing plans are checked as well
+	newPlan := plan
+	newPlan.Height += 100
+	newPlan.Info = ""
+	_, err = govHandler(ctx, NewReplaceSoftwareUpgradeProposal("title", "desc", newPlan, proposer))
+	require.Error(t, err)
+
+	// the release threshold can't exceed the number of the release keys
+	require.Error(t, ValidateGenesis(GenesisState{
+		TallyParams:   types.DefaultTallyParams(),
+		ReleaseParams: NewReleaseParams(keys[:1], 2),
+	}))
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	input := getTestInput(t, 1, GenesisState{}, nil)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block + 1)
+
+	// the node without the upgrade handler of the plan halts at its height
+	legacy := Plan{Name: "https://repo.decimalchain.com/1.4.0", Height: ctx.BlockHeight() + 10, ToDownload: 10}
+	require.NoError(t, input.keeper.ScheduleUpgrade(ctx, legacy))
+	require.False(t, input.keeper.HasUpgradeHandler(legacy))
+	require.Panics(t, func() { BeginBlocker(ctx.WithBlockHeight(legacy.Height), input.keeper) })
+
+	// the node running the binaries of a release without store migrations applies its plan
+	// with a handler doing nothing
+	input.keeper.SetUpgradeHandler(legacy.Release(), func(ctx sdk.Context, plan Plan) error { return nil })
+	BeginBlocker(ctx.WithBlockHeight(legacy.Height), input.keeper)
+	require.Equal(t, legacy.Height, input.keeper.GetDoneHeight(ctx, legacy.Name))
+	_, found := input.keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(legacy.Height + 1)
+	plan := Plan{Name: "https://repo.decimalchain.com/1.5.0", Height: ctx.BlockHeight() + 10, ToDownload: 10}
+	require.NoError(t, input.keeper.ScheduleUpgrade(ctx, plan))
+
+	runs := 0
+	input.keeper.SetUpgradeHandler(plan.Release(), func(ctx sdk.Context, plan Plan) error {
+		runs++
+		tallyParams := input.keeper.GetTallyParams(ctx)
+		tallyParams.MaxValidators = 20
+		input.keeper.SetTallyParams(ctx, tallyParams)
+		return nil
+	})
+	require.True(t, input.keeper.HasUpgradeHandler(plan))
+
+	// nothing is applied before the plan height
+	BeginBlocker(ctx.WithBlockHeight(plan.Height-1), input.keeper)
+	require.Equal(t, 0, runs)
+
+	ctx = ctx.WithBlockHeight(plan.Height)
+	BeginBlocker(ctx, input.keeper)
+	require.Equal(t, 1, runs)
+	require.Equal(t, uint64(20), input.keeper.GetTallyParams(ctx).MaxValidators)
+	require.Equal(t, plan.Height, input.keeper.GetDoneHeight(ctx, plan.Name))
+	_, found = input.keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// the migration runs once
+	require.Error(t, input.keeper.ApplyUpgrade(ctx, plan))
+	require.Error(t, input.keeper.ScheduleUpgrade(ctx, Plan{Name: plan.Name, Height: plan.Height + 10, ToDownload: 10}))
+	require.Equal(t, 1, runs)
+
+	// the store is left untouched by a failed migration
+	failing := Plan{Name: "https://repo.decimalchain.com/1.6.0", Height: plan.Height + 10, ToDownload: 10}
+	require.NoError(t, input.keeper.ScheduleUpgrade(ctx, failing))
+	input.keeper.SetUpgradeHandler(failing.Release(), func(ctx sdk.Context, plan Plan) error {
+		input.keeper.SetTallyParams(ctx, types.DefaultTallyParams())
+		return fmt.Errorf("migration failed")
+	})
+	ctx = ctx.WithBlockHeight(failing.Height)
+	require.Error(t, input.keeper.ApplyUpgrade(ctx, failing))
+	require.Equal(t, uint64(20), input.keeper.GetTallyParams(ctx).MaxValidators)
+	require.Equal(t, int64(0), input.keeper.GetDoneHeight(ctx, failing.Name))
+	_, found = input.keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+}
+
+func TestProposalQueries(t *testing.T) {
+	input := getTestInput(t, 2, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	validatorHandler := validator.NewHandler(input.vk)
+	govHandler := NewHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(updates.Update13Block)
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+	createValidators(t, validatorHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	validator.EndBlocker(ctx, input.vk, input.ck, input.sk, false)
+
+	submit := func(proposer string, votingStartBlock, votingEndBlock uint64) Proposal {
+		proposerAddr, err := sdk.AccAddressFromBech32(proposer)
+		require.NoError(t, err)
+		content := types.Content{Title: "title", Description: "desc"}
+		res, err := govHandler(ctx, NewMsgSubmitProposal(content, proposerAddr, votingStartBlock, votingEndBlock))
+		require.NoError(t, err)
+		proposal, ok := input.keeper.GetProposal(ctx, types.GetProposalIDFromBytes(res.Data))
+		require.True(t, ok)
+		require.Equal(t, proposerAddr, proposal.Proposer)
+		return proposal
+	}
+	height := uint64(ctx.BlockHeight())
+	first := submit(validator.DAOAddress1, height+5, height+10)
+	second := submit(validator.DAOAddress2, height+20, height+30)
+
+	filter := func(params types.QueryProposalsParams) []uint64 {
+		if params.Page == 0 {
+			params.Page = 1
+		}
+		var ids []uint64
+		for _, proposal := range input.keeper.GetProposalsFiltered(ctx, params) {
+			ids = append(ids, proposal.ProposalID)
+		}
+		return ids
+	}
+	secondProposer, err := sdk.AccAddressFromBech32(validator.DAOAddress2)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{first.ProposalID, second.ProposalID}, filter(types.QueryProposalsParams{Inactive: true}))
+	require.Empty(t, filter(types.QueryProposalsParams{ProposalStatus: StatusPassed}))
+	require.Equal(t, []uint64{second.ProposalID}, filter(types.QueryProposalsParams{Proposer: secondProposer}))
+	require.Equal(t, []uint64{second.ProposalID}, filter(types.QueryProposalsParams{FromBlock: height + 11}))
+	require.Equal(t, []uint64{first.ProposalID}, filter(types.QueryProposalsParams{ToBlock: height + 19}))
+	require.Equal(t, []uint64{second.ProposalID}, filter(types.QueryProposalsParams{Page: 2, Limit: 1}))
+
+	// nothing to vote on before the voting starts
+	require.Empty(t, input.keeper.GetVotableProposals(ctx, valAddr))
+
+	ctx = ctx.WithBlockHeight(int64(first.VotingStartBlock))
+	EndBlocker(ctx, input.keeper)
+
+	require.Equal(t, []uint64{second.ProposalID}, filter(types.QueryProposalsParams{Inactive: true}))
+	require.Equal(t, []uint64{first.ProposalID}, filter(types.QueryProposalsParams{ProposalStatus: StatusVotingPeriod}))
+
+	votable := input.keeper.GetVotableProposals(ctx, valAddr)
+	require.Len(t, votable, 1)
+	require.Equal(t, first.ProposalID, votable[0].ProposalID)
+	// only the counted validators may vote
+	require.Empty(t, input.keeper.GetVotableProposals(ctx, sdk.ValAddress(input.addrs[1])))
+
+	// the counted validator who didn't vote is counted as abstaining
+	breakdown := input.keeper.VoteBreakdown(ctx, votable[0])
+	require.Equal(t, []sdk.ValAddress{valAddr}, breakdown.NonVoters)
+	require.True(t, breakdown.Abstained)
+	require.Equal(t, types.OptionAbstain, breakdown.Validators[0].Option)
+
+	require.NoError(t, input.keeper.AddVote(ctx, first.ProposalID, valAddr, types.OptionYes))
+	breakdown = input.keeper.VoteBreakdown(ctx, votable[0])
+	require.Empty(t, breakdown.NonVoters)
+	require.Equal(t, types.OptionYes, breakdown.Validators[0].Option)
+}
@@ -6,17 +6,19 @@ import (
 )
 
 const (
-	ModuleName         = types.ModuleName
-	DefaultParamspace  = types.DefaultParamspace
-	RouterKey          = types.RouterKey
-	QuerierRoute       = types.QuerierRoute
-	StoreKey           = types.StoreKey
-	StatusNil          = types.StatusNil
-	StatusWaiting      = types.StatusWaiting
-	StatusVotingPeriod = types.StatusVotingPeriod
-	StatusPassed       = types.StatusPassed
-	StatusRejected     = types.StatusRejected
-	StatusFailed       = types.StatusFailed
+	ModuleName          = types.ModuleName
+	DefaultParamspace   = types.DefaultParamspace
+	RouterKey           = types.RouterKey
+	QuerierRoute        = types.QuerierRoute
+	StoreKey            = types.StoreKey
+	StatusNil           = types.StatusNil
+	StatusWaiting       = types.StatusWaiting
+	StatusVotingPeriod  = types.StatusVotingPeriod
+	StatusPassed        = types.StatusPassed
+	StatusRejected      = types.StatusRejected
+	StatusFailed        = types.StatusFailed
+	StatusDepositPeriod = types.StatusDepositPeriod
+	OptionNoWithVeto    = types.OptionNoWithVeto
 )
 
 var (
@@ -29,6 +31,17 @@ var (
 	NewQuerier           = keeper.NewQuerier
 	ParamKeyTable        = types.ParamKeyTable
 	NewMsgSubmitProposal = types.NewMsgSubmitProposal
+	NewParamChange       = types.NewParamChange
+	NewMsgDeposit        = types.NewMsgDeposit
+	NewCommunitySpend    = types.NewCommunitySpend
+	NewDepositParams     = types.NewDepositParams
+
+	NewCancelSoftwareUpgradeProposal  = types.NewCancelSoftwareUpgradeProposal
+	NewReplaceSoftwareUpgradeProposal = types.NewReplaceSoftwareUpgradeProposal
+
+	NewReleaseParams     = types.NewReleaseParams
+	NewReleaseManifest   = types.NewReleaseManifest
+	ParseReleaseManifest = types.ParseReleaseManifest
 )
 
 type (
@@ -41,4 +54,27 @@ type (
 	MsgSubmitProposal          = types.MsgSubmitProposal
 	MsgSoftwareUpgradeProposal = types.MsgSoftwareUpgradeProposal
 	MsgVote                    = types.MsgVote
+	ParamChange                = types.ParamChange
+	MsgDeposit                 = types.MsgDeposit
+	Deposit                    = types.Deposit
+	Deposits                   = types.Deposits
+	DepositParams              = types.DepositParams
+	VoteBreakdown              = types.VoteBreakdown
+	ValidatorVote              = types.ValidatorVote
+	DelegatorVote              = types.DelegatorVote
+	CommunitySpend             = types.CommunitySpend
+	TreasurySpend              = types.TreasurySpend
+	TreasurySpends             = types.TreasurySpends
+
+	MsgCancelSoftwareUpgradeProposal  = types.MsgCancelSoftwareUpgradeProposal
+	MsgReplaceSoftwareUpgradeProposal = types.MsgReplaceSoftwareUpgradeProposal
+	Plan                              = types.Plan
+	CancelledPlan                     = types.CancelledPlan
+	CancelledPlans                    = types.CancelledPlans
+
+	ReleaseParams    = types.ReleaseParams
+	ReleaseManifest  = types.ReleaseManifest
+	ReleaseBinary    = types.ReleaseBinary
+	ReleaseSignature = types.ReleaseSignature
+	UpgradeHandler   = types.UpgradeHandler
 )
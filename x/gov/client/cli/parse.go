@@ -16,6 +16,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.VotingStartBlock = viper.GetUint64(FlagVotingStartBlock)
 		proposal.VotingEndBlock = viper.GetUint64(FlagVotingEndBlock)
+		proposal.Deposit = viper.GetString(FlagDeposit)
 		return proposal, nil
 	}
 
@@ -1,6 +1,14 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"bitbucket.org/decimalteam/go-node/x/gov/client/launcher"
 	gcutils "bitbucket.org/decimalteam/go-node/x/gov/client/utils"
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	"fmt"
@@ -30,12 +38,22 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	govQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
+		GetCmdQueryVotableProposals(queryRoute, cdc),
 		GetCmdQueryVote(queryRoute, cdc),
 		GetCmdQueryVotes(queryRoute, cdc),
+		GetCmdQueryDeposit(queryRoute, cdc),
+		GetCmdQueryDeposits(queryRoute, cdc),
 		GetCmdQueryParam(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryProposer(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryBreakdown(queryRoute, cdc),
+		GetCmdQueryTreasury(queryRoute, cdc),
+		GetCmdQueryTreasurySpends(queryRoute, cdc),
+		GetCmdQueryUpgradePlan(queryRoute, cdc),
+		GetCmdQueryAppliedPlan(queryRoute, cdc),
+		GetCmdQueryCancelledPlans(queryRoute, cdc),
+		GetCmdVerifyRelease(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -89,13 +107,16 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (inactive|DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --proposer cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query gov proposals --from-block 7700000 --to-block 7800000
 $ %s query gov proposals --page=2 --limit=100
 `,
-				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			bechDepositorAddr := viper.GetString(flagDepositor)
 			bechVoterAddr := viper.GetString(flagVoter)
 			strProposalStatus := viper.GetString(flagStatus)
 			page := viper.GetInt(flags.FlagPage)
@@ -107,6 +128,14 @@ $ %s query gov proposals --page=2 --limit=100
 
 			params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
 
+			if len(bechDepositorAddr) != 0 {
+				depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
+				if err != nil {
+					return err
+				}
+				params.Depositor = depositorAddr
+			}
+
 			if len(bechVoterAddr) != 0 {
 				voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
 				if err != nil {
@@ -115,7 +144,9 @@ $ %s query gov proposals --page=2 --limit=100
 				params.Voter = voterAddr
 			}
 
-			if len(strProposalStatus) != 0 {
+			if strProposalStatus == types.StatusInactive {
+				params.Inactive = true
+			} else if len(strProposalStatus) != 0 {
 				proposalStatus, err := types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
 				if err != nil {
 					return err
@@ -123,6 +154,17 @@ $ %s query gov proposals --page=2 --limit=100
 				params.ProposalStatus = proposalStatus
 			}
 
+			if bechProposerAddr := viper.GetString(flagProposer); len(bechProposerAddr) != 0 {
+				proposerAddr, err := sdk.AccAddressFromBech32(bechProposerAddr)
+				if err != nil {
+					return err
+				}
+				params.Proposer = proposerAddr
+			}
+
+			params.FromBlock = viper.GetUint64(flagFromBlock)
+			params.ToBlock = viper.GetUint64(flagToBlock)
+
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -151,13 +193,63 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proposals to to query for")
 	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proposals to query for")
+	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: inactive/waiting/deposit_period/voting_period/passed/rejected/failed")
+	cmd.Flags().String(flagProposer, "", "(optional) filter by proposals submitted by proposer")
+	cmd.Flags().Uint64(flagFromBlock, 0, "(optional) filter by proposals whose voting ends at or after the block")
+	cmd.Flags().Uint64(flagToBlock, 0, "(optional) filter by proposals whose voting starts at or before the block")
 
 	return cmd
 }
 
 // Command to Get a Proposal Information
+// GetCmdQueryVotableProposals implements the query of the proposals a validator may vote on.
+func GetCmdQueryVotableProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votable-proposals [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the proposals in voting period a validator may vote on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the paginated proposals in voting period the validator may vote on.
+No proposals are returned if the validator is not among the validators whose votes are counted.
+
+Example:
+$ %s query gov votable-proposals dxvaloper16rr3cvdgj8jsywhx8lfteunn9uz0xg2czw6gx5
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryVotableProposalsParams(valAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVotableProposals), bz)
+			if err != nil {
+				return err
+			}
+
+			var proposals types.Proposals
+			cdc.MustUnmarshalJSON(res, &proposals)
+			return cliCtx.PrintOutput(proposals)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proposals to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proposals to query for")
+	return cmd
+}
+
 // GetCmdQueryVote implements the query proposal vote command.
 func GetCmdQueryVote(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -291,6 +383,116 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryDeposit implements the query proposal deposit command.
+func GetCmdQueryDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [proposal-id] [depositor-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of a deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a single proposal deposit on a proposal by its identifier.
+
+Example:
+$ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// check to see if the proposal is in the store
+			_, err = gcutils.QueryProposalByID(proposalID, cliCtx, queryRoute)
+			if err != nil {
+				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			depositorAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryDepositParams(proposalID, depositorAddr)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/deposit", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var deposit types.Deposit
+			cdc.MustUnmarshalJSON(res, &deposit)
+
+			if deposit.Empty() {
+				return fmt.Errorf("address '%s' has no deposit on proposal %d", depositorAddr, proposalID)
+			}
+
+			return cliCtx.PrintOutput(deposit)
+		},
+	}
+}
+
+// GetCmdQueryDeposits implements the command to query for proposal deposits.
+func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposits [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query deposits on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for all deposits on a proposal.
+You can find the proposal-id by running "%s query gov proposals".
+
+Deposits are refunded or burned when the proposal is tallied, so only
+deposits of proposals in progress are returned.
+
+Example:
+$ %s query gov deposits 1
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			params := types.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// check to see if the proposal is in the store
+			_, err = gcutils.QueryProposalByID(proposalID, cliCtx, queryRoute)
+			if err != nil {
+				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/deposits", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var deposits types.Deposits
+			cdc.MustUnmarshalJSON(res, &deposits)
+			return cliCtx.PrintOutput(deposits)
+		},
+	}
+}
+
 // GetCmdQueryTally implements the command to query for proposal tally result.
 func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -342,6 +544,58 @@ $ %s query gov tally 1
 	}
 }
 
+// GetCmdQueryBreakdown implements the command to query for the validator and delegator votes of a proposal.
+func GetCmdQueryBreakdown(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "breakdown [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the validator and delegator votes of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query how the votes on a proposal are counted: the option and the remaining power
+of every counted validator, and the stake of the delegators who override their
+validators with their own votes. You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov breakdown 1
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// check to see if the proposal is in the store
+			_, err = gcutils.QueryProposalByID(proposalID, cliCtx, queryRoute)
+			if err != nil {
+				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			// Construct query
+			params := types.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/breakdown", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var breakdown types.VoteBreakdown
+			cdc.MustUnmarshalJSON(res, &breakdown)
+			return cliCtx.PrintOutput(breakdown)
+		},
+	}
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -363,11 +617,23 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
+			dp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/deposit", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+			rp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/release", queryRoute), nil)
+			if err != nil {
+				return err
+			}
 
 			var tallyParams types.TallyParams
 			cdc.MustUnmarshalJSON(tp, &tallyParams)
+			var depositParams types.DepositParams
+			cdc.MustUnmarshalJSON(dp, &depositParams)
+			var releaseParams types.ReleaseParams
+			cdc.MustUnmarshalJSON(rp, &releaseParams)
 
-			return cliCtx.PrintOutput(types.NewParams(tallyParams))
+			return cliCtx.PrintOutput(types.NewParams(tallyParams, depositParams, releaseParams))
 		},
 	}
 }
@@ -377,14 +643,14 @@ func GetCmdQueryParam(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (tallying|deposit|release) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
 Example:
-$ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param release
 `,
 				version.ClientName, version.ClientName, version.ClientName,
 			),
@@ -403,8 +669,16 @@ $ %s query gov param deposit
 				var param types.TallyParams
 				cdc.MustUnmarshalJSON(res, &param)
 				out = param
+			case "deposit":
+				var param types.DepositParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
+			case "release":
+				var param types.ReleaseParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (tallying|deposit|release), was %s", args[0])
 			}
 
 			return cliCtx.PrintOutput(out)
@@ -445,3 +719,266 @@ $ %s query gov proposer 1
 		},
 	}
 }
+
+// GetCmdQueryTreasury implements the command to query for the treasury balance.
+func GetCmdQueryTreasury(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "treasury",
+		Args:  cobra.NoArgs,
+		Short: "Query the treasury balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins of the treasury spent by community spend proposals.
+
+Example:
+$ %s query gov treasury
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTreasury), nil)
+			if err != nil {
+				return err
+			}
+
+			var balance sdk.Coins
+			cdc.MustUnmarshalJSON(res, &balance)
+			return cliCtx.PrintOutput(balance)
+		},
+	}
+}
+
+// GetCmdQueryTreasurySpends implements the command to query for the treasury spend history.
+func GetCmdQueryTreasurySpends(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spends",
+		Args:  cobra.NoArgs,
+		Short: "Query the treasury spend history",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the community spends made by passed proposals.
+
+Example:
+$ %[1]s query gov treasury-spends
+$ %[1]s query gov treasury-spends --page=2 --limit=100
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryTreasurySpendsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySpends), bz)
+			if err != nil {
+				return err
+			}
+
+			var spends types.TreasurySpends
+			cdc.MustUnmarshalJSON(res, &spends)
+			return cliCtx.PrintOutput(spends)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of spends to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of spends to query for")
+	return cmd
+}
+
+// GetCmdQueryUpgradePlan implements the command to query for the scheduled upgrade plan.
+func GetCmdQueryUpgradePlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upgrade-plan",
+		Args:  cobra.NoArgs,
+		Short: "Query the scheduled upgrade plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the software upgrade plan scheduled and not cancelled yet.
+
+Example:
+$ %s query gov upgrade-plan
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCurrentPlan), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryAppliedPlan implements the command to query for the height of an applied upgrade.
+func GetCmdQueryAppliedPlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied-plan [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the height at which an upgrade was applied",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height at which the upgrade plan with the given name was applied.
+
+Example:
+$ %s query gov applied-plan https://repo.decimalchain.com/620004
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedPlanParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAppliedPlan), bz)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			if height == 0 {
+				return fmt.Errorf("upgrade %s has not been applied", args[0])
+			}
+
+			return cliCtx.PrintOutput(height)
+		},
+	}
+}
+
+// GetCmdQueryCancelledPlans implements the command to query for the cancelled and replaced upgrade plans.
+func GetCmdQueryCancelledPlans(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancelled-plans",
+		Args:  cobra.NoArgs,
+		Short: "Query the cancelled and replaced upgrade plans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the upgrade plans cancelled or replaced by proposals before their execution.
+
+Example:
+$ %s query gov cancelled-plans
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCancelledPlans), nil)
+			if err != nil {
+				return err
+			}
+
+			var plans types.CancelledPlans
+			cdc.MustUnmarshalJSON(res, &plans)
+			return cliCtx.PrintOutput(plans)
+		},
+	}
+}
+
+// GetCmdVerifyRelease implements the command to verify the signatures of a release manifest.
+func GetCmdVerifyRelease(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-release [manifest]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify that the release manifest is signed by the release keys",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify that the release manifest file is signed by as many of the release keys
+of the governance params as an upgrade plan requires. If the directory with the binaries
+of the release is given, their hashes are checked against the manifest as well.
+
+Example:
+$ %s query gov verify-release manifest.json
+$ %s query gov verify-release manifest.json --binaries-dir ./build --os-arch linux/ubuntu/20.04
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			manifest, err := types.ParseReleaseManifest(bz)
+			if err != nil {
+				return fmt.Errorf("invalid release manifest: %w", err)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryParams, types.ParamRelease), nil)
+			if err != nil {
+				return err
+			}
+			var releaseParams types.ReleaseParams
+			cdc.MustUnmarshalJSON(res, &releaseParams)
+
+			err = manifest.Verify(releaseParams.Keys, releaseParams.Threshold)
+			if err != nil {
+				return err
+			}
+
+			dir := viper.GetString(flagBinariesDir)
+			if dir != "" {
+				err = verifyBinaries(manifest, dir, viper.GetString(flagOSArch))
+				if err != nil {
+					return err
+				}
+			}
+
+			return cliCtx.PrintOutput(manifest)
+		},
+	}
+
+	cmd.Flags().String(flagBinariesDir, "", "(optional) directory with the binaries of the release to check")
+	cmd.Flags().String(flagOSArch, launcher.OSArch(), "OS the binaries in the directory are built for")
+	return cmd
+}
+
+// verifyBinaries checks that the files in the directory match the hashes of the binaries built for the OS
+func verifyBinaries(manifest types.ReleaseManifest, dir, osArch string) error {
+	found := false
+	for _, binary := range manifest.Binaries {
+		if binary.OSArch != osArch {
+			continue
+		}
+		found = true
+
+		f, err := os.Open(filepath.Join(dir, binary.File))
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		if hex.EncodeToString(h.Sum(nil)) != binary.Hash {
+			return fmt.Errorf("hash of %s does not match %s", binary.File, binary.Hash)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("release has no binaries for '%s'", osArch)
+	}
+	return nil
+}
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 	"time"
@@ -29,9 +32,16 @@ const (
 	FlagDescription      = "description"
 	FlagVotingStartBlock = "voting-start-block"
 	FlagVotingEndBlock   = "voting-end-block"
+	FlagDeposit          = "deposit"
 	flagVoter            = "voter"
+	flagDepositor        = "depositor"
 	flagStatus           = "status"
+	flagProposer         = "proposer"
+	flagFromBlock        = "from-block"
+	flagToBlock          = "to-block"
 	FlagProposal         = "proposal"
+	flagBinariesDir      = "binaries-dir"
+	flagOSArch           = "os-arch"
 )
 
 type proposal struct {
@@ -39,6 +49,9 @@ type proposal struct {
 	Description      string
 	VotingStartBlock uint64
 	VotingEndBlock   uint64
+	Deposit          string
+	Changes          []types.ParamChange
+	Spends           []types.CommunitySpend
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -49,6 +62,7 @@ var ProposalFlags = []string{
 	FlagDescription,
 	FlagVotingStartBlock,
 	FlagVotingEndBlock,
+	FlagDeposit,
 }
 
 // GetTxCmd returns the transaction commands for this module
@@ -67,8 +81,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	govTxCmd.AddCommand(flags.PostCommands(
 		GetCmdSubmitProposal(cdc),
+		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
 		GetCmdSubmitUpgradeProposal(cdc),
+		GetCmdCancelUpgradeProposal(cdc),
+		GetCmdReplaceUpgradeProposal(cdc),
 	)...)
 
 	return govTxCmd
@@ -93,11 +110,47 @@ Where proposal.json contains:
   "description": "My awesome proposal",
   "voting_start_block": 10000,
   "voting_end_block": 20000,
+  "deposit": "1000000000000000000000del"
 }
 
 Which is equivalent to:
 
-$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --voting_start_block 10000 --voting_end_block 20000 --from mykey
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --voting_start_block 10000 --voting_end_block 20000 --deposit="1000000000000000000000del" --from mykey
+
+Proposers which are not allowed by the gov deposit params have to reach the minimum deposit
+before the voting start block, the deposit is optional for allowed proposers.
+
+The proposal file may also list parameter changes applied when the proposal passes,
+values are JSON encoded the same way as in the genesis file:
+
+{
+  "title": "Max validators",
+  "description": "Increase the number of validators",
+  "voting_start_block": 10000,
+  "voting_end_block": 20000,
+  "changes": [
+    {
+      "subspace": "validator",
+      "key": "MaxValidators",
+      "value": "20"
+    }
+  ]
+}
+
+or transfers from the treasury made when the proposal passes:
+
+{
+  "title": "Public goods",
+  "description": "Fund the block explorer",
+  "voting_start_block": 10000,
+  "voting_end_block": 20000,
+  "spends": [
+    {
+      "recipient": "dx1fpjhs2wlaz6dd95d0lmxj5tfrmncwg437jh0y3",
+      "amount": [{"denom": "del", "amount": "1000000000000000000000"}]
+    }
+  ]
+}
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -112,10 +165,17 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return err
 			}
 
+			amount, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSubmitProposal(types.Content{
 				Title:       proposal.Title,
 				Description: proposal.Description,
-			}, cliCtx.GetFromAddress(), proposal.VotingStartBlock, proposal.VotingEndBlock)
+				Changes:     proposal.Changes,
+				Spends:      proposal.Spends,
+			}, cliCtx.GetFromAddress(), proposal.VotingStartBlock, proposal.VotingEndBlock).WithInitialDeposit(amount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -128,17 +188,65 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
 	cmd.Flags().String(FlagVotingStartBlock, "", "start block of voting")
 	cmd.Flags().String(FlagVotingEndBlock, "", "end block of voting")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
 }
 
+// GetCmdDeposit implements depositing tokens for a proposal waiting for the minimum deposit.
+func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [proposal-id] [deposit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit tokens for a proposal waiting for the minimum deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a deposit for a proposal which voting has not started yet. You can
+find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s tx gov deposit 1 10000000000000000000del --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get depositor address
+			from := cliCtx.GetFromAddress()
+
+			// Get amount of coins
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeposit(from, proposalID, amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "vote [proposal-id] [option]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Vote for an active proposal, options: yes/no/abstain",
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
@@ -186,10 +294,11 @@ const (
 	// TimeFormat specifies ISO UTC format for submitting the time for a new upgrade proposal
 	TimeFormat = "2006-01-02T15:04:05Z"
 
-	FlagUpgradeHeight = "upgrade-height"
-	FlagUpgradeTime   = "time"
-	FlagUpgradeInfo   = "upgrade-info"
-	FlagToDownload    = "upgrade-to-download"
+	FlagUpgradeHeight   = "upgrade-height"
+	FlagUpgradeTime     = "time"
+	FlagUpgradeInfo     = "upgrade-info"
+	FlagToDownload      = "upgrade-to-download"
+	FlagReleaseManifest = "release-manifest"
 )
 
 const (
@@ -236,6 +345,30 @@ func parseArgsToContent(cmd *cobra.Command, name string, proposer sdk.AccAddress
 		return types.MsgSoftwareUpgradeProposal{}, err
 	}
 
+	manifestFile, err := cmd.Flags().GetString(FlagReleaseManifest)
+	if err != nil {
+		return types.MsgSoftwareUpgradeProposal{}, err
+	}
+
+	if len(manifestFile) != 0 {
+		if len(info) != 0 {
+			return types.MsgSoftwareUpgradeProposal{}, fmt.Errorf("only one of --upgrade-info or --release-manifest should be specified")
+		}
+
+		bz, err := ioutil.ReadFile(manifestFile)
+		if err != nil {
+			return types.MsgSoftwareUpgradeProposal{}, err
+		}
+
+		// the signed manifest is put into the plan info as it is, without the formatting
+		var buf bytes.Buffer
+		err = json.Compact(&buf, bz)
+		if err != nil {
+			return types.MsgSoftwareUpgradeProposal{}, err
+		}
+		info = buf.String()
+	}
+
 	toDownload, err := cmd.Flags().GetInt64(FlagToDownload)
 	if err != nil {
 		return types.MsgSoftwareUpgradeProposal{}, err
@@ -279,6 +412,85 @@ func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
 	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
 	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().String(FlagReleaseManifest, "", "Signed release manifest file put into the info of the planned upgrade (not to be used together with --upgrade-info)")
+	cmd.Flags().Int64(FlagToDownload, 0, "How many blocks before the update you need to start downloading the new version")
+
+	return cmd
+}
+
+// GetCmdCancelUpgradeProposal implements a command handler for cancelling the scheduled software upgrade.
+func GetCmdCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Cancel the scheduled software upgrade",
+		Long: "Cancel the scheduled software upgrade.\n" +
+			"The upgrade plan is cleared and no node halts at its height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			title, err := cmd.Flags().GetString(FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewCancelSoftwareUpgradeProposal(title, description, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+
+	return cmd
+}
+
+// GetCmdReplaceUpgradeProposal implements a command handler for replacing the scheduled software upgrade.
+func GetCmdReplaceUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) (--upgrade-info [info]) [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the scheduled software upgrade",
+		Long: "Reschedule the scheduled software upgrade to a new height or binary.\n" +
+			"The new plan takes the same arguments as a software upgrade proposal and replaces the scheduled one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			upgrade, err := parseArgsToContent(cmd, name, from)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewReplaceSoftwareUpgradeProposal(upgrade.Title, upgrade.Description, upgrade.Plan, upgrade.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
+	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
+	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().String(FlagReleaseManifest, "", "Signed release manifest file put into the info of the planned upgrade (not to be used together with --upgrade-info)")
 	cmd.Flags().Int64(FlagToDownload, 0, "How many blocks before the update you need to start downloading the new version")
 
 	return cmd
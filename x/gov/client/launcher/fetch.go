@@ -0,0 +1,131 @@
+package launcher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"github.com/go-ini/ini"
+)
+
+// OSArch detects the os of the node, hashes of the binaries in the plan info are grouped by it
+func OSArch() string {
+	switch runtime.GOOS {
+	case "windows", "darwin":
+		return runtime.GOOS
+	case "linux":
+		distr := readOSRelease("ID")
+		if distr == "" {
+			distr = "<unknown>"
+		}
+		version := readOSRelease("VERSION_ID")
+		if version == "" {
+			version = "<unknown>"
+		}
+		return fmt.Sprintf("linux/%s/%s", distr, version)
+	default:
+		return runtime.GOOS
+	}
+}
+
+// Read the file under /etc/os-release to get the distribution name
+func readOSRelease(key string) string {
+	const cfgfile = "/etc/os-release"
+	cfg, err := ini.Load(cfgfile)
+	if err != nil {
+		return ""
+	}
+	return cfg.Section("").Key(key).String()
+}
+
+// joinLocation appends the elements to the location, which is either an http(s) or file:// url
+// or a local directory
+func joinLocation(location string, elem ...string) string {
+	u, err := url.Parse(location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "file") {
+		return filepath.Join(append([]string{location}, elem...)...)
+	}
+
+	u.Path = path.Join(append([]string{u.Path}, elem...)...)
+	return u.String()
+}
+
+// open opens the binary at the location for reading
+func open(location string) (io.ReadCloser, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return os.Open(location)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		resp, err := http.Get(location)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("download '%s' reply code is %d", location, resp.StatusCode)
+		}
+		return resp.Body, nil
+
+	case "file":
+		return os.Open(u.Path)
+
+	case "":
+		return os.Open(location)
+
+	default:
+		return nil, fmt.Errorf("unsupported location '%s'", location)
+	}
+}
+
+// fetch copies the binary from the location to the file and checks its sha256 hash.
+// The file is removed if the hash doesn't match.
+func fetch(location, filename, hash string) error {
+	r, err := open(location)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(out, h), r)
+	if err == nil && hash != hex.EncodeToString(h.Sum(nil)) {
+		err = fmt.Errorf("hash of '%s' does not match %s", location, hash)
+	}
+	if err != nil {
+		os.Remove(filename)
+		return err
+	}
+
+	return nil
+}
+
+// fileHashEqual checks that the file exists and has the given sha256 hash
+func fileHashEqual(filename, hash string) bool {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return false
+	}
+	return hash == hex.EncodeToString(h.Sum(nil))
+}
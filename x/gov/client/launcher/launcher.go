@@ -0,0 +1,382 @@
+package launcher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	ncfg "bitbucket.org/decimalteam/go-node/config"
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+)
+
+// Layout of the launcher directory:
+//
+// - genesis/bin: binaries the node was started with
+//
+// - upgrades/<plan>/bin: binaries of the upgrade plans
+//
+// - current: link to the directory with binaries the node runs
+const (
+	genesisDir  = "genesis"
+	upgradesDir = "upgrades"
+	currentLink = "current"
+	binDir      = "bin"
+)
+
+// Launcher supervises the node, switches it to the binaries of the upgrade plan at the halt
+// height and restarts it. Binaries are fetched from the location named by the plan, which is
+// either an http(s) or file:// url or a local directory.
+type Launcher struct {
+	root        string // launcher directory
+	source      string // location used instead of the location named by plans, if set
+	osArch      string
+	updatesInfo *ncfg.UpdatesInfoStruct
+	logger      log.Logger
+
+	prepared map[string]bool
+	plans    map[string]types.Plan // plans queried from the chain by name
+}
+
+func NewLauncher(root, source, osArch string, updatesInfo *ncfg.UpdatesInfoStruct, logger log.Logger) *Launcher {
+	return &Launcher{
+		root:        root,
+		source:      source,
+		osArch:      osArch,
+		updatesInfo: updatesInfo,
+		logger:      logger,
+		prepared:    make(map[string]bool),
+		plans:       make(map[string]types.Plan),
+	}
+}
+
+// Binary returns the path of the binary the node currently runs
+func (l *Launcher) Binary(name string) string {
+	return filepath.Join(l.root, currentLink, binDir, name)
+}
+
+// Install copies the binaries the node is started with from the directory and switches to them
+func (l *Launcher) Install(dir string) error {
+	genesis := filepath.Join(l.root, genesisDir)
+	err := os.MkdirAll(filepath.Join(genesis, binDir), 0755)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range ncfg.NameFiles {
+		err = copyFile(filepath.Join(dir, name), filepath.Join(genesis, binDir, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return l.link(genesis)
+}
+
+// Prepare fetches the binaries of the plan and checks that they match the hashes of the release
+// manifest or the legacy plan info and run on this node
+func (l *Launcher) Prepare(plan types.Plan) error {
+	if l.prepared[plan.Name+plan.Info] {
+		return nil
+	}
+
+	/* NOTE:
+	checksum generator of the legacy plan info saves files' hashes as array in order 1) decd 2) deccli
+	ncfg.NameFiles must be []string{"decd", "deccli"}
+	*/
+	hashes, ok := plan.Hashes(l.osArch, ncfg.NameFiles)
+	if !ok {
+		return fmt.Errorf("plan \"%s\" has no binaries for '%s'", plan.Name, l.osArch)
+	}
+
+	dir := filepath.Join(l.upgradeDir(plan), binDir)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	location := plan.Name
+	if l.source != "" {
+		location = joinLocation(l.source, plan.Release())
+	}
+
+	for i, name := range ncfg.NameFiles {
+		filename := filepath.Join(dir, name)
+		if fileHashEqual(filename, hashes[i]) {
+			continue
+		}
+
+		// example: "https://repo.decimalchain.com/95000/linux/ubuntu/20.04/decd"
+		binary := joinLocation(location, l.osArch, name)
+		l.logger.Info("fetching binary", "plan", plan.Name, "location", binary)
+		err = fetch(binary, filename, hashes[i])
+		if err != nil {
+			return err
+		}
+
+		err = os.Chmod(filename, 0755)
+		if err != nil {
+			return err
+		}
+
+		err = exec.Command(filename, "version").Run()
+		if err != nil {
+			os.Remove(filename)
+			return fmt.Errorf("binary '%s' does not run: %w", binary, err)
+		}
+	}
+
+	l.prepared[plan.Name+plan.Info] = true
+	return nil
+}
+
+// Switch switches the node to the binaries of the plan and marks the plan as executed to start the
+// grace period of slashing
+func (l *Launcher) Switch(plan types.Plan) error {
+	err := l.Prepare(plan)
+	if err != nil {
+		return err
+	}
+
+	err = l.link(l.upgradeDir(plan))
+	if err != nil {
+		return err
+	}
+
+	err = l.updatesInfo.Load()
+	if err != nil {
+		return err
+	}
+	l.updatesInfo.PushNewPlanHeight(plan.Height)
+	l.updatesInfo.AddExecutedPlan(plan.Name, plan.Height)
+	return l.updatesInfo.Save()
+}
+
+// Run starts the node with the arguments and restarts it with the binaries of the plan each time
+// the node halts for an upgrade. The scheduled plan is queried from the node through the client and
+// its binaries are fetched ahead of the plan height. Run returns when the node stops for another
+// reason or the context is done.
+func (l *Launcher) Run(ctx context.Context, client rpcclient.ABCIClient, args []string, pollInterval time.Duration) error {
+	for {
+		plan, found, err := l.runNode(ctx, client, args, pollInterval)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !found {
+			return err
+		}
+
+		l.logger.Info("upgrading node", "plan", plan.Name, "height", plan.Height)
+		err = l.Switch(plan)
+		if err != nil {
+			return fmt.Errorf("failed to upgrade node to \"%s\": %w", plan.Name, err)
+		}
+	}
+}
+
+// runNode runs the node until it stops or halts for an upgrade plan. The node halts by logging
+// the upgrade message of the plan and panicking in the begin blocker, so the launcher watches the
+// node output for the message and stops the node once the message is logged.
+func (l *Launcher) runNode(ctx context.Context, client rpcclient.ABCIClient, args []string, pollInterval time.Duration) (types.Plan, bool, error) {
+	// ncfg.NameFiles[0] is decd
+	cmd := exec.Command(l.Binary(ncfg.NameFiles[0]), args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return types.Plan{}, false, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return types.Plan{}, false, err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return types.Plan{}, false, err
+	}
+
+	halted := make(chan types.Plan, 2)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go watchOutput(stdout, os.Stdout, halted, &wg)
+	go watchOutput(stderr, os.Stderr, halted, &wg)
+
+	done := make(chan error, 1)
+	go func() {
+		// the output must be read before waiting for the node
+		wg.Wait()
+		done <- cmd.Wait()
+	}()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err = <-done:
+			select {
+			case plan := <-halted:
+				plan, err = l.scheduledPlan(client, plan)
+				return plan, err == nil, err
+			default:
+				return types.Plan{}, false, err
+			}
+		case plan := <-halted:
+			// the halted node still serves queries
+			plan, err = l.scheduledPlan(client, plan)
+			_ = cmd.Process.Signal(syscall.SIGTERM)
+			<-done
+			return plan, err == nil, err
+		case <-ctx.Done():
+			_ = cmd.Process.Signal(syscall.SIGTERM)
+			return types.Plan{}, false, <-done
+		case <-ticker.C:
+			if client != nil {
+				l.prefetch(client)
+			}
+		}
+	}
+}
+
+// prefetch fetches the binaries of the scheduled plan when the node is close to the plan height
+func (l *Launcher) prefetch(client rpcclient.ABCIClient) {
+	plan, height, found := l.queryPlan(client)
+	if !found || height <= plan.Height-plan.ToDownload {
+		return
+	}
+
+	err := l.Prepare(plan)
+	if err != nil {
+		l.logger.Error(fmt.Sprintf("failed to prepare plan \"%s\": %s", plan.Name, err.Error()))
+	}
+}
+
+// queryPlan queries the scheduled plan and the height of the chain from the node and keeps the plan
+// for the halt of the node
+func (l *Launcher) queryPlan(client rpcclient.ABCIClient) (types.Plan, int64, bool) {
+	res, err := client.ABCIQuery(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentPlan), nil)
+	if err != nil || len(res.Response.Value) == 0 {
+		// the node is not started yet or there is no plan
+		return types.Plan{}, 0, false
+	}
+
+	var plan types.Plan
+	err = types.ModuleCdc.UnmarshalJSON(res.Response.Value, &plan)
+	if err != nil {
+		l.logger.Error(fmt.Sprintf("failed to decode plan: %s", err.Error()))
+		return types.Plan{}, 0, false
+	}
+
+	l.plans[plan.Name] = plan
+	return plan, res.Response.Height, true
+}
+
+// scheduledPlan returns the plan the node halted for as it is stored on the chain, queried from the
+// halted node or kept since the last query. The upgrade message only names the plan.
+func (l *Launcher) scheduledPlan(client rpcclient.ABCIClient, halted types.Plan) (types.Plan, error) {
+	if client != nil {
+		l.queryPlan(client)
+	}
+
+	plan, ok := l.plans[halted.Name]
+	if !ok {
+		return types.Plan{}, fmt.Errorf("plan \"%s\" the node halted for is not found on the chain", halted.Name)
+	}
+	return plan, nil
+}
+
+// upgradeNeeded matches the message the node logs when it halts for the upgrade plan, see the gov
+// begin blocker: UPGRADE "<name>" NEEDED at height: <height>: <info>, or at time: <time> for the plans
+// executed at the time. The info is taken from the plan stored on the chain.
+var upgradeNeeded = regexp.MustCompile(`UPGRADE "(.*)" NEEDED at (height: (\d+)|time: (\S+)):`)
+
+// watchOutput copies the node output to the writer and sends the plan the node halts for
+func watchOutput(r io.Reader, w io.Writer, halted chan<- types.Plan, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	found := false
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		_, _ = io.WriteString(w, line)
+		if !found {
+			if plan, ok := parseUpgradeNeeded(line); ok {
+				found = true
+				halted <- plan
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// parseUpgradeNeeded parses the name and the due of the plan from the upgrade message in the line
+func parseUpgradeNeeded(line string) (types.Plan, bool) {
+	match := upgradeNeeded.FindStringSubmatch(line)
+	if match == nil {
+		return types.Plan{}, false
+	}
+
+	plan := types.Plan{Name: match[1]}
+	if match[3] != "" {
+		height, err := strconv.ParseInt(match[3], 10, 64)
+		if err != nil {
+			return types.Plan{}, false
+		}
+		plan.Height = height
+	} else {
+		t, err := time.Parse(time.RFC3339, match[4])
+		if err != nil {
+			return types.Plan{}, false
+		}
+		plan.Time = t
+	}
+	return plan, true
+}
+
+// upgradeDir returns the directory with binaries of the plan, named by the release of the plan
+func (l *Launcher) upgradeDir(plan types.Plan) string {
+	return filepath.Join(l.root, upgradesDir, plan.Release())
+}
+
+// link points the current link to the directory
+func (l *Launcher) link(dir string) error {
+	current := filepath.Join(l.root, currentLink)
+	tmp := current + ".tmp"
+	err := os.Remove(tmp)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	err = os.Symlink(dir, tmp)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, current)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
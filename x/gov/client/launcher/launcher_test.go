@@ -0,0 +1,232 @@
+package launcher
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	ncfg "bitbucket.org/decimalteam/go-node/config"
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+)
+
+const testOSArch = "linux/ubuntu/20.04"
+
+func calcHash(source []byte) string {
+	h := sha256.Sum256(source)
+	return hex.EncodeToString(h[:])
+}
+
+func newTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "gotest")
+	require.NoError(t, err)
+	return dir
+}
+
+func TestFetch(t *testing.T) {
+	testBytes := make([]byte, 1024*1024)
+	_, err := rand.Read(testBytes)
+	require.NoError(t, err)
+	normalHash := calcHash(testBytes)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.String() {
+		case "/file":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write(testBytes) // nolint: errcheck
+		case "/500_error":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("error")) // nolint: errcheck
+		case "/301_redirect":
+			http.Redirect(w, r, "/file", http.StatusMovedPermanently)
+		}
+	}))
+	defer ts.Close()
+
+	dir := newTestDir(t)
+	defer os.RemoveAll(dir)
+	localFile := filepath.Join(dir, "source")
+	require.NoError(t, ioutil.WriteFile(localFile, testBytes, 0644))
+	testFilepath := filepath.Join(dir, "testfile")
+
+	testSuite := []struct {
+		msg      string
+		location string
+		hash     string
+		ok       bool
+	}{
+		{"normal download", ts.URL + "/file", normalHash, true},
+		{"wrong hash", ts.URL + "/file", normalHash + "0", false},
+		{"http error download", ts.URL + "/500_error", normalHash, false},
+		{"redirect", ts.URL + "/301_redirect", normalHash, true},
+		{"local file", localFile, normalHash, true},
+		{"file url", "file://" + localFile, normalHash, true},
+		{"missing local file", localFile + "_missing", normalHash, false},
+	}
+	for _, suite := range testSuite {
+		err := fetch(suite.location, testFilepath, suite.hash)
+		if suite.ok {
+			require.NoError(t, err, suite.msg)
+			require.True(t, fileHashEqual(testFilepath, normalHash), suite.msg)
+		} else {
+			require.Error(t, err, suite.msg)
+			_, err = os.Stat(testFilepath)
+			require.True(t, os.IsNotExist(err), suite.msg)
+		}
+		os.Remove(testFilepath)
+	}
+}
+
+func TestJoinLocation(t *testing.T) {
+	require.Equal(t, "https://repo.decimalchain.com/95000/linux/ubuntu/20.04/decd",
+		joinLocation("https://repo.decimalchain.com/95000", testOSArch, "decd"))
+	require.Equal(t, "file:///srv/releases/95000/linux/ubuntu/20.04/decd",
+		joinLocation("file:///srv/releases", "95000", testOSArch, "decd"))
+	require.Equal(t, "/srv/releases/95000/linux/ubuntu/20.04/decd",
+		joinLocation("/srv/releases", "95000", testOSArch, "decd"))
+}
+
+// writeRelease writes the scripts standing for decd and deccli of the release and returns their hashes.
+// The decd script appends its release name to the log file when it runs the node. If the halt message
+// is set, the node prints it and keeps running like the node halted by the begin blocker.
+func writeRelease(t *testing.T, dir, release, logFile, halt string) []string {
+	binDir := filepath.Join(dir, release, testOSArch)
+	require.NoError(t, os.MkdirAll(binDir, 0755))
+
+	var hashes []string
+	for _, name := range ncfg.NameFiles {
+		script := []byte(fmt.Sprintf("#!/bin/sh\n# %s\n[ \"$1\" = version ] && exit 0\necho %s >> %s\n", name, release, logFile))
+		if halt != "" {
+			script = append(script, fmt.Sprintf("echo '%s'\nexec sleep 60\n", halt)...)
+		}
+		require.NoError(t, ioutil.WriteFile(filepath.Join(binDir, name), script, 0755))
+		hashes = append(hashes, calcHash(script))
+	}
+	return hashes
+}
+
+// testClient serves the scheduled plan like the node does
+type testClient struct {
+	rpcclient.ABCIClient
+	plan types.Plan
+}
+
+func (c testClient) ABCIQuery(path string, data tmbytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Value:  types.ModuleCdc.MustMarshalJSON(c.plan),
+		Height: c.plan.Height - 1,
+	}}, nil
+}
+
+func TestRunUpgradesNode(t *testing.T) {
+	dir := newTestDir(t)
+	defer os.RemoveAll(dir)
+
+	releases := filepath.Join(dir, "releases")
+	logFile := filepath.Join(dir, "node.log")
+	hashes := writeRelease(t, releases, "7700100", logFile, "")
+
+	// the genesis node halts for the plan, which names a local directory with the binaries,
+	// the info with spaces is taken from the plan on the chain
+	info := fmt.Sprintf(`{"%s": ["%s", "%s"]}`, testOSArch, hashes[0], hashes[1])
+	plan := types.Plan{Name: "file://" + filepath.Join(releases, "7700100"), Height: 7700100, Info: info}
+	halt := fmt.Sprintf("E[2021-06-01|12:00:00.000] UPGRADE \"%s\" NEEDED at %s: %s module=main", plan.Name, plan.DueAt(), plan.Info)
+	writeRelease(t, releases, "genesis", logFile, halt)
+
+	updatesInfo := ncfg.NewUpdatesInfo(filepath.Join(dir, ncfg.UpdatesName))
+	l := NewLauncher(filepath.Join(dir, "launcher"), "", testOSArch, updatesInfo, log.NewNopLogger())
+	require.NoError(t, l.Install(filepath.Join(releases, "genesis", testOSArch)))
+
+	// the plan the node halted for is unknown without the chain
+	require.Error(t, l.Run(context.Background(), nil, nil, time.Second))
+	require.Empty(t, updatesInfo.AllBlocks)
+
+	require.NoError(t, l.Run(context.Background(), testClient{plan: plan}, nil, time.Second))
+
+	// the genesis node halted, the upgraded node was started and stopped without an upgrade
+	out, err := ioutil.ReadFile(logFile)
+	require.NoError(t, err)
+	require.Equal(t, "genesis\ngenesis\n7700100\n", string(out))
+	require.Equal(t, map[string]int64{plan.Name: plan.Height}, updatesInfo.AllBlocks)
+	require.Equal(t, plan.Height, updatesInfo.LastBlock)
+
+	current, err := filepath.EvalSymlinks(l.Binary("deccli"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "launcher", upgradesDir, "7700100", binDir, "deccli"), current)
+}
+
+func TestPrepareRejectsWrongBinaries(t *testing.T) {
+	dir := newTestDir(t)
+	defer os.RemoveAll(dir)
+
+	releases := filepath.Join(dir, "releases")
+	hashes := writeRelease(t, releases, "7700100", filepath.Join(dir, "node.log"), "")
+
+	l := NewLauncher(filepath.Join(dir, "launcher"), releases, testOSArch, nil, log.NewNopLogger())
+
+	// the source replaces the location named by the plan
+	info := fmt.Sprintf(`{"%s":["%s","%s"]}`, testOSArch, hashes[0], hashes[0])
+	plan := types.Plan{Name: "https://repo.decimalchain.com/7700100", Height: 7700100, Info: info, ToDownload: 10}
+	require.Error(t, l.Prepare(plan))
+
+	plan.Info = fmt.Sprintf(`{"linux/centos/7":["%s","%s"]}`, hashes[0], hashes[1])
+	require.Error(t, l.Prepare(plan))
+
+	plan.Info = fmt.Sprintf(`{"%s":["%s","%s"]}`, testOSArch, hashes[0], hashes[1])
+	require.NoError(t, l.Prepare(plan))
+}
+
+func TestPrepareFromReleaseManifest(t *testing.T) {
+	dir := newTestDir(t)
+	defer os.RemoveAll(dir)
+
+	releases := filepath.Join(dir, "releases")
+	hashes := writeRelease(t, releases, "7700100", filepath.Join(dir, "node.log"), "")
+
+	l := NewLauncher(filepath.Join(dir, "launcher"), releases, testOSArch, nil, log.NewNopLogger())
+
+	plan := types.Plan{Name: "https://repo.decimalchain.com/7700100", Height: 7700100, ToDownload: 10}
+	manifest := types.NewReleaseManifest(plan.Name, []types.ReleaseBinary{
+		{File: "deccli", OSArch: testOSArch, Hash: hashes[1]},
+		{File: "decd", OSArch: testOSArch, Hash: hashes[0]},
+		{File: "decd", OSArch: "linux/centos/7", Hash: hashes[1]},
+	})
+	bz, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	plan.Info = string(bz)
+	require.NoError(t, l.Prepare(plan))
+
+	// binaries are taken for the os of the node only
+	l = NewLauncher(filepath.Join(dir, "launcher"), releases, "linux/centos/7", nil, log.NewNopLogger())
+	require.Error(t, l.Prepare(plan))
+}
+
+func TestParseUpgradeNeeded(t *testing.T) {
+	plan, ok := parseUpgradeNeeded(`E[2021-06-01|12:00:00.000] UPGRADE "https://repo.decimalchain.com/1.5.0" NEEDED at height: 7700100: {"linux/ubuntu/20.04":["a","b"]} module=main`)
+	require.True(t, ok)
+	require.Equal(t, types.Plan{Name: "https://repo.decimalchain.com/1.5.0", Height: 7700100}, plan)
+
+	plan, ok = parseUpgradeNeeded(`panic: UPGRADE "https://repo.decimalchain.com/1.5.0" NEEDED at time: 2021-06-01T12:00:00Z: `)
+	require.True(t, ok)
+	require.Equal(t, "https://repo.decimalchain.com/1.5.0", plan.Name)
+	require.Equal(t, time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC), plan.Time.UTC())
+
+	_, ok = parseUpgradeNeeded(`I[2021-06-01|12:00:00.000] applying upgrade "https://repo.decimalchain.com/1.5.0" at height: 7700100`)
+	require.False(t, ok)
+}
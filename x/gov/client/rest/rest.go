@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -17,6 +18,11 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestPlanName       = "name"
+	RestProposer       = "proposer"
+	RestFromBlock      = "from_block"
+	RestToBlock        = "to_block"
+	RestValidatorAddr  = "validator-addr"
 )
 
 // ProposalRESTHandler defines a REST handler implemented in another module. The
@@ -40,6 +46,17 @@ type PostProposalReq struct {
 	Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`       // Address of the proposer
 	VotingStartBlock string         `json:"voting_start_block" yaml:"voting_start_block"`
 	VotingEndBlock   string         `json:"voting_end_block" yaml:"voting_end_block"`
+	InitialDeposit   sdk.Coins      `json:"initial_deposit,omitempty" yaml:"initial_deposit,omitempty"` // Coins to add to the proposal's deposit
+
+	Changes []types.ParamChange    `json:"changes,omitempty" yaml:"changes,omitempty"` // Parameter changes applied when the proposal passes
+	Spends  []types.CommunitySpend `json:"spends,omitempty" yaml:"spends,omitempty"`   // Transfers from the treasury made when the proposal passes
+}
+
+// DepositReq defines the properties of a deposit request's body.
+type DepositReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"` // Address of the depositor
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`       // Coins to add to the proposal's deposit
 }
 
 // VoteReq defines the properties of a vote request's body.
@@ -173,6 +173,9 @@ func NormalizeVoteOption(option string) string {
 	case "No", "no":
 		return types.OptionNo.String()
 
+	case "NoWithVeto", "no_with_veto":
+		return types.OptionNoWithVeto.String()
+
 	default:
 		return ""
 	}
@@ -181,14 +184,18 @@ func NormalizeVoteOption(option string) string {
 //NormalizeProposalStatus - normalize user specified proposal status
 func NormalizeProposalStatus(status string) string {
 	switch status {
+	case "Waiting", "waiting":
+		return "Waiting"
 	case "DepositPeriod", "deposit_period":
 		return "DepositPeriod"
-	case "VotingPeriod", "voting_period":
+	case "VotingPeriod", "voting_period", "voting":
 		return "VotingPeriod"
 	case "Passed", "passed":
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Failed", "failed":
+		return "Failed"
 	}
 	return ""
 }
@@ -9,6 +9,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 
 	k.SetProposalID(ctx, data.StartingProposalID)
 	k.SetTallyParams(ctx, data.TallyParams)
+	// Genesis states exported before the params were initialized have no deposit params
+	if !data.DepositParams.IsEmpty() {
+		k.SetDepositParams(ctx, data.DepositParams)
+	}
+	k.SetReleaseParams(ctx, data.ReleaseParams)
+
+	for _, deposit := range data.Deposits {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	for _, spend := range data.TreasurySpends {
+		k.SetTreasurySpend(ctx, spend)
+	}
 
 	for _, vote := range data.Votes {
 		k.SetVote(ctx, vote)
@@ -16,7 +29,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
-		case StatusWaiting:
+		case StatusDepositPeriod, StatusWaiting:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingStartBlock)
 		case StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndBlock)
@@ -29,13 +42,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	depositParams := k.GetDepositParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsVotes Votes
+	var proposalsDeposits Deposits
 	for _, proposal := range proposals {
 
 		votes := k.GetVotes(ctx, proposal.ProposalID)
 		proposalsVotes = append(proposalsVotes, votes...)
+
+		deposits := k.GetDeposits(ctx, proposal.ProposalID)
+		proposalsDeposits = append(proposalsDeposits, deposits...)
 	}
 
 	return GenesisState{
@@ -43,5 +61,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
 		TallyParams:        tallyParams,
+		Deposits:           proposalsDeposits,
+		DepositParams:      depositParams,
+		TreasurySpends:     k.GetTreasurySpends(ctx),
+		ReleaseParams:      k.GetReleaseParams(ctx),
 	}
 }
@@ -29,9 +29,18 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case types.MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case types.MsgDeposit:
+			return handleMsgDeposit(ctx, keeper, msg)
+
 		case types.MsgSoftwareUpgradeProposal:
 			return handleSoftwareUpgradeProposal(ctx, keeper, msg)
 
+		case types.MsgCancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, keeper, msg)
+
+		case types.MsgReplaceSoftwareUpgradeProposal:
+			return handleReplaceSoftwareUpgradeProposal(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -39,19 +48,69 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg types.MsgSubmitProposal) (*sdk.Result, error) {
-	if !types.CheckProposalAddress(msg.Proposer) {
-		return nil, types.ErrNotAllowed()
+	// Whitelisted proposers submit proposals without a deposit, anybody else has to reach
+	// the minimum deposit before the voting starts
+	allowed := keeper.IsAllowedProposer(ctx, msg.Proposer)
+	if !keeper.IsParamsInitialized(ctx) {
+		if !allowed {
+			return nil, types.ErrNotAllowed()
+		}
+		if !msg.InitialDeposit.Empty() {
+			return nil, types.ErrInvalidDeposit("deposits are not enabled yet")
+		}
 	}
 
 	if int64(msg.VotingStartBlock) <= ctx.BlockHeight() {
 		return nil, types.ErrStartBlock()
 	}
 
+	if !allowed {
+		depositParams := keeper.GetDepositParams(ctx)
+		if depositParams.MinDeposit.Empty() {
+			return nil, types.ErrNotAllowed()
+		}
+		if msg.VotingStartBlock-uint64(ctx.BlockHeight()) > depositParams.MaxDepositPeriod {
+			return nil, types.ErrDepositPeriodTooLong(strconv.FormatUint(depositParams.MaxDepositPeriod, 10))
+		}
+	}
+
+	if len(msg.Content.Changes) > 0 {
+		if !keeper.IsParamsInitialized(ctx) {
+			return nil, types.ErrInvalidParamChange(msg.Content.Changes[0].Subspace, msg.Content.Changes[0].Key, "parameter changes are not enabled yet")
+		}
+		err := keeper.ValidateParamChanges(ctx, msg.Content.Changes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(msg.Content.Spends) > 0 && !keeper.IsParamsInitialized(ctx) {
+		return nil, types.ErrInvalidCommunitySpend("community spends are not enabled yet")
+	}
+
 	proposal, err := keeper.SubmitProposal(ctx, msg.Content, msg.VotingStartBlock, msg.VotingEndBlock)
 	if err != nil {
 		return nil, types.ErrSubmitProposal(err.Error())
 	}
 
+	// Once the params are initialized the proposer is kept with the proposal
+	if keeper.IsParamsInitialized(ctx) {
+		proposal.Proposer = msg.Proposer
+		keeper.SetProposal(ctx, proposal)
+	}
+
+	if !allowed {
+		proposal.Status = types.StatusDepositPeriod
+		keeper.SetProposal(ctx, proposal)
+	}
+
+	if !msg.InitialDeposit.Empty() {
+		_, err = keeper.AddDeposit(ctx, proposal.ProposalID, msg.Proposer, msg.InitialDeposit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -71,9 +130,15 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg types.MsgSubmit
 }
 
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg types.MsgVote) (*sdk.Result, error) {
+	// Once the params are initialized delegators vote with their own accounts to override their validators
 	err := keeper.CheckValidator(ctx, msg.Voter)
 	if err != nil {
-		return nil, err
+		if !keeper.IsParamsInitialized(ctx) {
+			return nil, err
+		}
+		if keeper.CheckDelegator(ctx, sdk.AccAddress(msg.Voter)) != nil {
+			return nil, err
+		}
 	}
 
 	err = keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
@@ -94,6 +159,37 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg types.MsgVote) (*sdk.Resu
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg types.MsgDeposit) (*sdk.Result, error) {
+	if !keeper.IsParamsInitialized(ctx) {
+		return nil, types.ErrInvalidDeposit("deposits are not enabled yet")
+	}
+
+	votingStarted, err := keeper.AddDeposit(ctx, msg.ProposalID, msg.Depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(msg.ProposalID, 10)),
+		),
+	)
+
+	if votingStarted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalDeposit,
+				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, strconv.FormatUint(msg.ProposalID, 10)),
+			),
+		)
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.MsgSoftwareUpgradeProposal) (*sdk.Result, error) {
 	err := k.ScheduleUpgrade(ctx, p.Plan)
 	if err != nil {
@@ -117,3 +213,67 @@ func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.MsgSoftwar
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.MsgCancelSoftwareUpgradeProposal) (*sdk.Result, error) {
+	if !k.IsParamsInitialized(ctx) {
+		return nil, types.ErrInvalidUpgrade("cancelling upgrades is not enabled yet")
+	}
+
+	plan, err := k.CancelUpgrade(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The height pushed for the cancelled plan must not start the grace period of slashing
+	if ncfg.UpdatesInfo.LastBlock > ctx.BlockHeight() {
+		ncfg.UpdatesInfo.PopPlanHeight()
+		err = ncfg.UpdatesInfo.Save()
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("pop plan \"%s\" with error: %s", plan.Name, err.Error()))
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, p.Proposer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradeName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(plan.Height, 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleReplaceSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.MsgReplaceSoftwareUpgradeProposal) (*sdk.Result, error) {
+	if !k.IsParamsInitialized(ctx) {
+		return nil, types.ErrInvalidUpgrade("replacing upgrades is not enabled yet")
+	}
+
+	oldPlan, err := k.ReplaceUpgrade(ctx, p.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, p.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(p.Plan.Height, 10)),
+		),
+		sdk.NewEvent(
+			types.EventTypeReplaceUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradeName, p.Plan.Name),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(p.Plan.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyPreviousName, oldPlan.Name),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, strconv.FormatInt(oldPlan.Height, 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
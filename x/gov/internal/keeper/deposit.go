@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+)
+
+// GetDeposit gets the deposit of a specific depositor on a specific proposal
+func (keeper Keeper) GetDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) (deposit types.Deposit, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DepositKey(proposalID, depositorAddr))
+	if bz == nil {
+		return deposit, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+	return deposit, true
+}
+
+// SetDeposit sets a Deposit to the gov store
+func (keeper Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(types.DepositKey(deposit.ProposalID, deposit.Depositor), bz)
+}
+
+// GetAllDeposits returns all the deposits from the store
+func (keeper Keeper) GetAllDeposits(ctx sdk.Context) (deposits types.Deposits) {
+	keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return
+}
+
+// GetDeposits returns all the deposits from a proposal
+func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits types.Deposits) {
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return
+}
+
+// IterateAllDeposits iterates over the all the stored deposits and performs a callback function
+func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// IterateDeposits iterates over the all the proposals deposits and performs a callback function
+func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func(deposit types.Deposit) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// AddDeposit adds or updates a deposit of a specific depositor on a specific proposal.
+// Deposits are accepted until the voting of the proposal starts. It returns true if the
+// deposit moved the proposal out of the deposit period.
+func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return false, types.ErrUnknownProposal(strconv.FormatUint(proposalID, 10))
+	}
+	if proposal.Status != types.StatusDepositPeriod && proposal.Status != types.StatusWaiting {
+		return false, types.ErrInactiveDepositPeriod(strconv.FormatUint(proposalID, 10))
+	}
+
+	// Only coins of the minimum deposit are accepted, other coins can not be burned without
+	// breaking their volume and reserve
+	depositParams := keeper.GetDepositParams(ctx)
+	if !depositAmount.DenomsSubsetOf(depositParams.MinDeposit) {
+		return false, types.ErrInvalidDeposit(fmt.Sprintf("%s is not a subset of the minimum deposit %s", depositAmount, depositParams.MinDeposit))
+	}
+
+	err := keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
+	if err != nil {
+		return false, err
+	}
+
+	activatedVotingPeriod := false
+	proposal.TotalDeposit = proposal.TotalDeposit.Add(depositAmount...)
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(depositParams.MinDeposit) {
+		proposal.Status = types.StatusWaiting
+		activatedVotingPeriod = true
+	}
+	keeper.SetProposal(ctx, proposal)
+
+	deposit, found := keeper.GetDeposit(ctx, proposalID, depositorAddr)
+	if found {
+		deposit.Amount = deposit.Amount.Add(depositAmount...)
+	} else {
+		deposit = types.NewDeposit(proposalID, depositorAddr, depositAmount)
+	}
+	keeper.SetDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalDeposit,
+			sdk.NewAttribute(types.AttributeKeyAmount, depositAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return activatedVotingPeriod, nil
+}
+
+// RefundDeposits refunds and deletes all the deposits on a specific proposal
+func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.deleteDeposit(ctx, proposalID, deposit.Depositor)
+	}
+}
+
+// DeleteDeposits burns and deletes all the deposits on a specific proposal
+func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
+	amount := sdk.NewCoins()
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		amount = amount.Add(deposit.Amount...)
+		keeper.deleteDeposit(ctx, proposalID, deposit.Depositor)
+	}
+
+	if amount.Empty() {
+		return
+	}
+
+	err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// deleteDeposit deletes a deposit from a given proposalID and depositor from the store
+func (keeper Keeper) deleteDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DepositKey(proposalID, depositorAddr))
+}
@@ -19,6 +19,9 @@ type Keeper struct {
 	// The reference to the Paramstore to get and set gov specific params
 	paramSpace types.ParamSubspace
 
+	// The reference to the ParamsKeeper to change params of all modules by proposals
+	paramsKeeper types.ParamsKeeper
+
 	// The SupplyKeeper to reduce the supply of the network
 	supplyKeeper types.SupplyKeeper
 
@@ -35,6 +38,9 @@ type Keeper struct {
 	router types.Router
 
 	skipUpgradeHeights map[int64]bool
+
+	// Store migrations run at the height the plan with their name executes
+	upgradeHandlers map[string]types.UpgradeHandler
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -45,7 +51,7 @@ type Keeper struct {
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace, paramsKeeper types.ParamsKeeper,
 	supplyKeeper types.SupplyKeeper, vk types.ValidatorKeeper, rtr types.Router,
 ) Keeper {
 
@@ -57,10 +63,13 @@ func NewKeeper(
 	return Keeper{
 		storeKey:     key,
 		paramSpace:   paramSpace,
+		paramsKeeper: paramsKeeper,
 		supplyKeeper: supplyKeeper,
 		vk:           vk,
 		cdc:          cdc,
 		router:       rtr,
+
+		upgradeHandlers: make(map[string]types.UpgradeHandler),
 	}
 }
 
@@ -170,6 +179,15 @@ func (keeper Keeper) CheckValidator(ctx sdk.Context, address sdk.ValAddress) err
 		return fmt.Errorf("voter is not a validator")
 	}
 
+	// once the params are initialized the voters are the validators counted by the tally
+	if keeper.IsParamsInitialized(ctx) {
+		_, currValidators := keeper.getCurrValidators(ctx)
+		if _, ok := currValidators[address.String()]; !ok {
+			return fmt.Errorf("voter doesn't have enough power voting")
+		}
+		return nil
+	}
+
 	var val exported.ValidatorI
 
 	keeper.vk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) bool {
@@ -197,6 +215,23 @@ func (keeper Keeper) CheckValidator(ctx sdk.Context, address sdk.ValAddress) err
 	return nil
 }
 
+// CheckDelegator checks that the voter delegated to one of the validators whose votes are counted
+func (keeper Keeper) CheckDelegator(ctx sdk.Context, address sdk.AccAddress) error {
+	_, currValidators := keeper.getCurrValidators(ctx)
+
+	found := false
+	keeper.vk.IterateDelegatorDelegations(ctx, address, func(delegation exported.DelegationI) bool {
+		_, found = currValidators[delegation.GetValidatorAddr().String()]
+		return found
+	})
+
+	if !found {
+		return fmt.Errorf("voter is not a validator and doesn't delegate to a voting validator")
+	}
+
+	return nil
+}
+
 func (k Keeper) Get(ctx sdk.Context, key []byte, value *int64) error {
 	store := ctx.KVStore(k.storeKey)
 	err := k.cdc.UnmarshalBinaryLengthPrefixed(store.Get(key), value)
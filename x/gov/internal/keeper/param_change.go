@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+)
+
+// ValidateParamChanges checks that the changes can be applied to the current params without applying them
+func (keeper Keeper) ValidateParamChanges(ctx sdk.Context, changes []types.ParamChange) error {
+	cacheCtx, _ := ctx.CacheContext()
+	return keeper.applyParamChanges(cacheCtx, changes)
+}
+
+// ApplyParamChanges applies either all the changes or none of them when one of the changes is invalid
+func (keeper Keeper) ApplyParamChanges(ctx sdk.Context, changes []types.ParamChange) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := keeper.applyParamChanges(cacheCtx, changes)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+func (keeper Keeper) applyParamChanges(ctx sdk.Context, changes []types.ParamChange) error {
+	for _, change := range changes {
+		err := keeper.applyParamChange(ctx, change)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyParamChange updates the param validating the value by the validator of its subspace
+func (keeper Keeper) applyParamChange(ctx sdk.Context, change types.ParamChange) (err error) {
+	subspace, ok := keeper.paramsKeeper.GetSubspace(change.Subspace)
+	if !ok {
+		return types.ErrInvalidParamChange(change.Subspace, change.Key, "unknown subspace")
+	}
+
+	// the subspace panics on keys which are not registered in its key table
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = types.ErrInvalidParamChange(change.Subspace, change.Key, fmt.Sprint(r))
+		}
+	}()
+
+	err = subspace.Update(ctx, []byte(change.Key), []byte(change.Value))
+	if err != nil {
+		return types.ErrInvalidParamChange(change.Subspace, change.Key, err.Error())
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,3 +17,82 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// GetDepositParams returns the current DepositParams from the global param store.
+// Empty params are returned until deposits are enabled by InitParams.
+func (keeper Keeper) GetDepositParams(ctx sdk.Context) types.DepositParams {
+	var depositParams types.DepositParams
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	return depositParams
+}
+
+// SetDepositParams sets DepositParams to the global param store
+func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+// GetReleaseParams returns the current ReleaseParams from the global param store
+func (keeper Keeper) GetReleaseParams(ctx sdk.Context) types.ReleaseParams {
+	var releaseParams types.ReleaseParams
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyReleaseParams, &releaseParams)
+	return releaseParams
+}
+
+// SetReleaseParams sets ReleaseParams to the global param store
+func (keeper Keeper) SetReleaseParams(ctx sdk.Context, releaseParams types.ReleaseParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyReleaseParams, &releaseParams)
+}
+
+// IsParamsInitialized returns true once the deposit params are set by InitParams or by the genesis.
+// The rules of the proposals, votes and tally kept in the params apply since then. The params are
+// read without consuming gas, so that checking them doesn't change the gas used by the transactions.
+func (keeper Keeper) IsParamsInitialized(ctx sdk.Context) bool {
+	return keeper.paramSpace.Has(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyDepositParams)
+}
+
+// InitParams sets the default deposit params and the default tally rules if they are not set yet
+func (keeper Keeper) InitParams(ctx sdk.Context) {
+	if keeper.GetDepositParams(ctx).IsEmpty() {
+		keeper.SetDepositParams(ctx, types.NewDepositParams(
+			sdk.NewCoins(sdk.NewCoin(keeper.vk.BondDenom(ctx), types.DefaultMinDepositAmount)),
+			types.DefaultMaxDepositPeriod,
+			types.AllowedAddresses,
+		))
+	}
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	if tallyParams.Veto.IsNil() {
+		tallyParams.Veto = types.DefaultVeto
+	}
+	if tallyParams.MaxValidators == 0 {
+		tallyParams.MaxValidators = types.DefaultMaxValidators
+		tallyParams.NonVotersAbstain = types.DefaultNonVotersAbstain
+	}
+	keeper.SetTallyParams(ctx, tallyParams)
+}
+
+// tallyRules returns the number of the validators with the most power whose votes are counted,
+// zero if all the bonded validators are counted, and whether the counted validators who don't
+// vote abstain. The rules are taken from the tally params once the params are initialized.
+func (keeper Keeper) tallyRules(ctx sdk.Context) (maxValidators int64, nonVotersAbstain bool) {
+	if keeper.IsParamsInitialized(ctx) {
+		tallyParams := keeper.GetTallyParams(ctx)
+		if tallyParams.MaxValidators > 0 {
+			return int64(tallyParams.MaxValidators), tallyParams.NonVotersAbstain
+		}
+	}
+
+	if ctx.BlockHeight() >= updates.Update1Block {
+		return int64(types.DefaultMaxValidators), true
+	}
+
+	return 0, false
+}
+
+// IsAllowedProposer returns true if the address may submit proposals without a deposit
+func (keeper Keeper) IsAllowedProposer(ctx sdk.Context, address sdk.AccAddress) bool {
+	if !keeper.IsParamsInitialized(ctx) {
+		return types.CheckProposalAddress(address)
+	}
+	return keeper.GetDepositParams(ctx).IsAllowedProposer(address)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -88,6 +89,11 @@ func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Pr
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
+		// the proposal queues and the next proposal ID share the prefix of the proposals
+		if len(iterator.Key()) != len(types.ProposalsKeyPrefix)+8 {
+			continue
+		}
+
 		proposal, err := UnmarshalProposal(keeper.cdc, iterator.Value())
 		switch {
 		case err != nil && strings.HasPrefix(err.Error(), "Bytes left over in UnmarshalBinaryLengthPrefixed,"):
@@ -111,6 +117,85 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 	return
 }
 
+// GetProposalsFiltered returns the proposals matching the status, voter and depositor of the params, paginated
+func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryProposalsParams) types.Proposals {
+	proposals := keeper.GetProposals(ctx)
+	filteredProposals := make([]types.Proposal, 0, len(proposals))
+
+	for _, p := range proposals {
+		matchVoter, matchDepositor, matchStatus, matchProposer, matchBlocks := true, true, true, true, true
+
+		// match status (if supplied/valid)
+		if types.ValidProposalStatus(params.ProposalStatus) {
+			matchStatus = p.Status == params.ProposalStatus
+		}
+
+		// match the proposals pending their voting start block (if requested)
+		if params.Inactive {
+			matchStatus = matchStatus && (p.Status == types.StatusWaiting || p.Status == types.StatusDepositPeriod)
+		}
+
+		// match proposer (if supplied)
+		if len(params.Proposer) > 0 {
+			matchProposer = p.Proposer.Equals(params.Proposer)
+		}
+
+		// match the voting period overlapping the block range (if supplied)
+		if params.FromBlock > 0 && p.VotingEndBlock < params.FromBlock {
+			matchBlocks = false
+		}
+		if params.ToBlock > 0 && p.VotingStartBlock > params.ToBlock {
+			matchBlocks = false
+		}
+
+		// match voter address (if supplied)
+		if len(params.Voter) > 0 {
+			_, matchVoter = keeper.GetVote(ctx, p.ProposalID, sdk.ValAddress(params.Voter))
+		}
+
+		// match depositor (if supplied)
+		if len(params.Depositor) > 0 {
+			_, matchDepositor = keeper.GetDeposit(ctx, p.ProposalID, params.Depositor)
+		}
+
+		if matchVoter && matchDepositor && matchStatus && matchProposer && matchBlocks {
+			filteredProposals = append(filteredProposals, p)
+		}
+	}
+
+	start, end := client.Paginate(len(filteredProposals), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		filteredProposals = []types.Proposal{}
+	} else {
+		filteredProposals = filteredProposals[start:end]
+	}
+
+	return filteredProposals
+}
+
+// GetVotableProposals returns the proposals in voting period the validator may vote on, none
+// if the validator is not among the voters
+func (keeper Keeper) GetVotableProposals(ctx sdk.Context, valAddr sdk.ValAddress) types.Proposals {
+	proposals := types.Proposals{}
+	if keeper.CheckValidator(ctx, valAddr) != nil {
+		return proposals
+	}
+
+	keeper.IterateAllActiveProposalsQueue(ctx, func(proposal types.Proposal) bool {
+		if proposal.Status != types.StatusVotingPeriod {
+			return false
+		}
+		if keeper.IsParamsInitialized(ctx) && ctx.BlockHeight() >= int64(proposal.VotingEndBlock) {
+			return false
+		}
+
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	return proposals
+}
+
 // amino methods
 
 func MustMarshaProposal(cdc *codec.Codec, ubd types.Proposal) []byte {
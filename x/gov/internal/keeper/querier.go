@@ -1,12 +1,285 @@
 package keeper
 
 import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 )
 
+// NewQuerier creates a new gov Querier instance
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, path[1:], req, keeper)
+
+		case types.QueryProposals:
+			return queryProposals(ctx, path[1:], req, keeper)
+
+		case types.QueryProposal:
+			return queryProposal(ctx, path[1:], req, keeper)
+
+		case types.QueryDeposits:
+			return queryDeposits(ctx, path[1:], req, keeper)
+
+		case types.QueryDeposit:
+			return queryDeposit(ctx, path[1:], req, keeper)
+
+		case types.QueryVotes:
+			return queryVotes(ctx, path[1:], req, keeper)
+
+		case types.QueryVote:
+			return queryVote(ctx, path[1:], req, keeper)
+
+		case types.QueryTally:
+			return queryTally(ctx, path[1:], req, keeper)
+
+		case types.QueryBreakdown:
+			return queryBreakdown(ctx, path[1:], req, keeper)
+
+		case types.QueryVotableProposals:
+			return queryVotableProposals(ctx, path[1:], req, keeper)
+
+		case types.QueryTreasury:
+			return queryTreasury(ctx, path[1:], req, keeper)
+
+		case types.QuerySpends:
+			return querySpends(ctx, path[1:], req, keeper)
+
+		case types.QueryCurrentPlan:
+			return queryCurrentPlan(ctx, path[1:], req, keeper)
+
+		case types.QueryAppliedPlan:
+			return queryAppliedPlan(ctx, path[1:], req, keeper)
+
+		case types.QueryCancelledPlans:
+			return queryCancelledPlans(ctx, path[1:], req, keeper)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "param type is not specified")
+	}
+
+	switch path[0] {
+	case types.ParamTallying:
+		return codec.MarshalJSONIndent(keeper.cdc, keeper.GetTallyParams(ctx))
+
+	case types.ParamDeposit:
+		return codec.MarshalJSONIndent(keeper.cdc, keeper.GetDepositParams(ctx))
+
+	case types.ParamRelease:
+		return codec.MarshalJSONIndent(keeper.cdc, keeper.GetReleaseParams(ctx))
+
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
+	}
+}
+
+func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, types.ErrUnknownProposal(strconv.FormatUint(params.ProposalID, 10))
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, proposal)
+}
+
+func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposals := keeper.GetProposalsFiltered(ctx, params)
+	if proposals == nil {
+		proposals = types.Proposals{}
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, proposals)
+}
+
+func queryDeposit(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryDepositParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	deposit, _ := keeper.GetDeposit(ctx, params.ProposalID, params.Depositor)
+	return codec.MarshalJSONIndent(keeper.cdc, deposit)
+}
+
+func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	deposits := keeper.GetDeposits(ctx, params.ProposalID)
+	if deposits == nil {
+		deposits = types.Deposits{}
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, deposits)
+}
+
+func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryVoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	vote, _ := keeper.GetVote(ctx, params.ProposalID, sdk.ValAddress(params.Voter))
+	return codec.MarshalJSONIndent(keeper.cdc, vote)
+}
+
+func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalVotesParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	votes := keeper.GetVotes(ctx, params.ProposalID)
+	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		votes = types.Votes{}
+	} else {
+		votes = votes[start:end]
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, votes)
+}
+
+func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, types.ErrUnknownProposal(strconv.FormatUint(params.ProposalID, 10))
+	}
+
+	var tallyResult types.TallyResult
+	switch proposal.Status {
+	case types.StatusDepositPeriod, types.StatusWaiting:
+		tallyResult = types.EmptyTallyResult()
+
+	case types.StatusPassed, types.StatusRejected, types.StatusFailed:
+		tallyResult = proposal.FinalTallyResult
+
+	default:
+		// Tally deletes the votes, so it is run on a cached context
+		cacheCtx, _ := ctx.CacheContext()
+		_, _, tallyResult, _ = keeper.Tally(cacheCtx, proposal)
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, tallyResult)
+}
+
+func queryBreakdown(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, types.ErrUnknownProposal(strconv.FormatUint(params.ProposalID, 10))
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, keeper.VoteBreakdown(ctx, proposal))
+}
+
+func queryVotableProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryVotableProposalsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposals := keeper.GetVotableProposals(ctx, params.Validator)
+	start, end := client.Paginate(len(proposals), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		proposals = types.Proposals{}
+	} else {
+		proposals = proposals[start:end]
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, proposals)
+}
+
+func queryTreasury(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	return codec.MarshalJSONIndent(keeper.cdc, keeper.GetTreasuryBalance(ctx))
+}
+
+func querySpends(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTreasurySpendsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	spends := keeper.GetTreasurySpends(ctx)
+	start, end := client.Paginate(len(spends), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		spends = types.TreasurySpends{}
+	} else {
+		spends = spends[start:end]
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, spends)
+}
+
+func queryCurrentPlan(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
 		return nil, nil
 	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, plan)
+}
+
+func queryAppliedPlan(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAppliedPlanParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, keeper.GetDoneHeight(ctx, params.Name))
+}
+
+func queryCancelledPlans(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	plans := keeper.GetCancelledPlans(ctx)
+	if plans == nil {
+		plans = types.CancelledPlans{}
+	}
+
+	return codec.MarshalJSONIndent(keeper.cdc, plans)
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
 	"bitbucket.org/decimalteam/go-node/x/validator/exported"
@@ -8,93 +10,221 @@ import (
 )
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, tallyResults types.TallyResult, totalVotingPower sdk.Dec) {
+// voters. Once the params are initialized the deposits of the proposal are burned if it is vetoed or
+// if the validators who voted don't reach the quorum, delegators who voted themselves override the
+// votes of their validators with their own stake, and the result lists the counted validators.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult, totalVotingPower sdk.Dec) {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
+	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower = sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.vk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		if ctx.BlockHeight() >= updates.Update1Block {
-			if index == 9 {
-				return true
-			}
-		}
-
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			types.OptionEmpty,
-		)
+	votedPower := sdk.ZeroDec()
 
-		return false
-	})
+	_, nonVotersAbstain := keeper.tallyRules(ctx)
+	validators, currValidators, delegators, votes := keeper.tallyVotes(ctx, proposal)
+	for _, vote := range votes {
+		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+	}
 
-	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
-		// if validator, just record it in the map
-		valAddrStr := vote.Voter.String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
-		}
+	// the stake of the delegators who voted themselves is counted for their own option
+	for _, del := range delegators {
+		votingPower := sdk.NewDecFromInt(del.Stake)
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
-		return false
-	})
+		results[del.Vote] = results[del.Vote].Add(votingPower)
+		votedPower = votedPower.Add(votingPower)
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
+		votingPower := val.BondedTokens.Sub(val.DelegatorDeductions)
+
 		if val.Vote == types.OptionEmpty {
-			if ctx.BlockHeight() >= updates.Update1Block {
+			if nonVotersAbstain {
 				val.Vote = types.OptionAbstain
 			} else {
 				continue
 			}
+		} else {
+			votedPower = votedPower.Add(sdk.NewDecFromInt(votingPower))
 		}
 
-		votingPower := val.BondedTokens
-
 		results[val.Vote] = results[val.Vote].Add(sdk.NewDecFromInt(votingPower))
 		totalVotingPower = totalVotingPower.Add(sdk.NewDecFromInt(votingPower))
 	}
 
 	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = types.NewTallyResultFromMap(results)
+	if keeper.IsParamsInitialized(ctx) {
+		tallyResults.Validators = validators
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.vk.TotalBondedTokens(ctx).IsZero() {
-		return false, tallyResults, totalVotingPower
+		return false, false, tallyResults, totalVotingPower
+	}
+
+	if keeper.IsParamsInitialized(ctx) && totalVotingPower.IsPositive() {
+		// If too much voting power vetoes the proposal, it fails and its deposits are burned
+		if !tallyParams.Veto.IsNil() && results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+			return false, true, tallyResults, totalVotingPower
+		}
+
+		// If too few validators vote, the deposits are burned
+		burnDeposits = votedPower.Quo(totalVotingPower).LT(tallyParams.Quorum)
 	}
 
 	if ctx.BlockHeight() >= updates.Update1Block {
 		// If no one votes (everyone abstains), proposal fails
 		if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-			return false, tallyResults, totalVotingPower
+			return false, burnDeposits, tallyResults, totalVotingPower
 		}
 
 		if results[types.OptionYes].Quo(totalVotingPower).GT(tallyParams.Quorum) {
-			return true, tallyResults, totalVotingPower
+			return true, burnDeposits, tallyResults, totalVotingPower
 		}
 
-		return false, tallyResults, totalVotingPower
+		return false, burnDeposits, tallyResults, totalVotingPower
 	} else {
 		// If there is not enough quorum of votes, the proposal fails
 		percentVoting := totalVotingPower.Quo(keeper.vk.TotalBondedTokens(ctx).ToDec())
 		if percentVoting.LT(tallyParams.Quorum) {
-			return false, tallyResults, totalVotingPower
+			return false, false, tallyResults, totalVotingPower
 		}
 
 		// If no one votes (everyone abstains), proposal fails
 		if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-			return false, tallyResults, totalVotingPower
+			return false, false, tallyResults, totalVotingPower
 		}
 
-		return true, tallyResults, totalVotingPower
+		return true, false, tallyResults, totalVotingPower
 	}
 }
+
+// VoteBreakdown returns the validator and the delegator views of the votes on a proposal as they
+// would be counted by Tally at the current height
+func (keeper Keeper) VoteBreakdown(ctx sdk.Context, proposal types.Proposal) types.VoteBreakdown {
+	_, nonVotersAbstain := keeper.tallyRules(ctx)
+	validators, currValidators, delegators, _ := keeper.tallyVotes(ctx, proposal)
+
+	breakdown := types.VoteBreakdown{
+		ProposalID: proposal.ProposalID,
+		Validators: []types.ValidatorVote{},
+		Delegators: []types.DelegatorVote{},
+		NonVoters:  []sdk.ValAddress{},
+		Abstained:  nonVotersAbstain,
+	}
+	for _, valAddr := range validators {
+		val := currValidators[valAddr.String()]
+		if val.Vote == types.OptionEmpty {
+			breakdown.NonVoters = append(breakdown.NonVoters, val.Address)
+			if nonVotersAbstain {
+				val.Vote = types.OptionAbstain
+			}
+		}
+		breakdown.Validators = append(breakdown.Validators, types.ValidatorVote{
+			Validator:  val.Address,
+			Option:     val.Vote,
+			Power:      val.BondedTokens.Sub(val.DelegatorDeductions),
+			Deductions: val.DelegatorDeductions,
+		})
+	}
+	for _, del := range delegators {
+		breakdown.Delegators = append(breakdown.Delegators, types.DelegatorVote{
+			Delegator: del.Address,
+			Option:    del.Vote,
+			Power:     del.Stake,
+		})
+	}
+
+	return breakdown
+}
+
+// tallyVotes reads the votes on a proposal and returns the counted validators in the order of
+// their power together with the delegators who override the votes of these validators
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposal types.Proposal) (validators []sdk.ValAddress, currValidators map[string]types.ValidatorGovInfo, delegators []types.DelegatorGovInfo, votes types.Votes) {
+	validators, currValidators = keeper.getCurrValidators(ctx)
+
+	votes = keeper.GetVotes(ctx, proposal.ProposalID)
+	for _, vote := range votes {
+		// if validator, just record it in the map
+		valAddrStr := vote.Voter.String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Option
+			currValidators[valAddrStr] = val
+		}
+	}
+
+	if !keeper.IsParamsInitialized(ctx) {
+		return validators, currValidators, delegators, votes
+	}
+
+	// the stake delegated by a voter to a counted validator other than itself is moved from
+	// the validator to the option of the voter
+	for _, vote := range votes {
+		delegator := types.NewDelegatorGovInfo(sdk.AccAddress(vote.Voter), vote.Option)
+
+		keeper.vk.IterateDelegatorDelegations(ctx, delegator.Address, func(delegation exported.DelegationI) (stop bool) {
+			if delegation.GetValidatorAddr().Equals(vote.Voter) {
+				return false
+			}
+
+			valAddrStr := delegation.GetValidatorAddr().String()
+			val, ok := currValidators[valAddrStr]
+			if !ok {
+				return false
+			}
+
+			stake := sdk.MinInt(keeper.delegationStake(ctx, delegation), val.BondedTokens.Sub(val.DelegatorDeductions))
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(stake)
+			currValidators[valAddrStr] = val
+			delegator.Stake = delegator.Stake.Add(stake)
+
+			return false
+		})
+
+		if delegator.Stake.IsPositive() {
+			delegators = append(delegators, delegator)
+		}
+	}
+
+	return validators, currValidators, delegators, votes
+}
+
+// delegationStake returns the value of a delegation in base coin, custom coins are valued the
+// same way as the stake of their validator
+func (keeper Keeper) delegationStake(ctx sdk.Context, delegation exported.DelegationI) sdk.Int {
+	if strings.ToLower(delegation.GetCoin().Denom) == keeper.vk.BondDenom(ctx) {
+		return delegation.GetCoin().Amount
+	}
+
+	return keeper.vk.TokenBaseOfDelegation(ctx, delegation)
+}
+
+// getCurrValidators returns the bonded validators whose votes are counted in the order of their power
+func (keeper Keeper) getCurrValidators(ctx sdk.Context) (validators []sdk.ValAddress, currValidators map[string]types.ValidatorGovInfo) {
+	currValidators = make(map[string]types.ValidatorGovInfo)
+	maxValidators, _ := keeper.tallyRules(ctx)
+
+	// fetch all the bonded validators, insert them into currValidators
+	keeper.vk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		if maxValidators > 0 && index == maxValidators {
+			return true
+		}
+
+		validators = append(validators, validator.GetOperator())
+		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			types.OptionEmpty,
+		)
+
+		return false
+	})
+
+	return validators, currValidators
+}
@@ -67,7 +67,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, _, _ := keeper.Tally(ctx, proposal)
+	passes, _, _, _ := keeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 }
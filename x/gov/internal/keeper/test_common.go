@@ -157,9 +157,9 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		Volume: coinConfig.InitialVolumeBaseCoin,
 	})
 
-	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bankKeeper)
+	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bankKeeper, nil)
 
-	nftKeeper := nft.NewKeeper(cdc, keyNFT, supplyKeeper, validator.DefaultBondDenom)
+	nftKeeper := nft.NewKeeper(cdc, keyNFT, supplyKeeper, bankKeeper, validator.DefaultBondDenom)
 
 	sk := validator.NewKeeper(cdc, keyStaking, pk.Subspace(validator.DefaultParamSpace), coinKeeper, accountKeeper, supplyKeeper, multisigKeeper, nftKeeper, auth.FeeCollectorName)
 	sk.SetParams(ctx, validator.DefaultParams())
@@ -176,11 +176,12 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	rtr := types.NewRouter()
 
 	keeper := NewKeeper(
-		cdc, keyCoin, pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()), supplyKeeper, sk, rtr,
+		cdc, keyCoin, pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()), pk, supplyKeeper, sk, rtr,
 	)
 
 	keeper.SetProposalID(ctx, types.DefaultStartingProposalID)
 	keeper.SetTallyParams(ctx, types.DefaultTallyParams())
+	keeper.SetDepositParams(ctx, types.DefaultDepositParams())
 
 	keeper.supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
 	keeper.supplyKeeper.SetModuleAccount(ctx, govAcc)
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/gov/internal/types"
+	"bitbucket.org/decimalteam/go-node/x/validator"
+)
+
+// GetTreasuryBalance returns the coins of the treasury module account
+func (keeper Keeper) GetTreasuryBalance(ctx sdk.Context) sdk.Coins {
+	treasury := keeper.supplyKeeper.GetModuleAccount(ctx, validator.TreasuryPoolName)
+	if treasury == nil {
+		return sdk.NewCoins()
+	}
+	return treasury.GetCoins()
+}
+
+// SpendFromTreasury transfers the community spends of a proposal from the treasury to their
+// recipients and records them in the spend history
+func (keeper Keeper) SpendFromTreasury(ctx sdk.Context, proposalID uint64, spends []types.CommunitySpend) error {
+	if len(spends) == 0 {
+		return nil
+	}
+
+	total := sdk.NewCoins()
+	for _, spend := range spends {
+		total = total.Add(spend.Amount...)
+	}
+	balance := keeper.GetTreasuryBalance(ctx)
+	if !balance.IsAllGTE(total) {
+		return types.ErrInvalidCommunitySpend(fmt.Sprintf("treasury balance %s is less than %s", balance, total))
+	}
+
+	for _, spend := range spends {
+		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, validator.TreasuryPoolName, spend.Recipient, spend.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	keeper.SetTreasurySpend(ctx, types.NewTreasurySpend(proposalID, ctx.BlockHeight(), spends))
+	return nil
+}
+
+// GetTreasurySpend gets the treasury spend made by a specific proposal
+func (keeper Keeper) GetTreasurySpend(ctx sdk.Context, proposalID uint64) (spend types.TreasurySpend, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.TreasurySpendKey(proposalID))
+	if bz == nil {
+		return spend, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &spend)
+	return spend, true
+}
+
+// SetTreasurySpend sets a TreasurySpend to the gov store
+func (keeper Keeper) SetTreasurySpend(ctx sdk.Context, spend types.TreasurySpend) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(spend)
+	store.Set(types.TreasurySpendKey(spend.ProposalID), bz)
+}
+
+// GetTreasurySpends returns the treasury spend history ordered by proposal
+func (keeper Keeper) GetTreasurySpends(ctx sdk.Context) (spends types.TreasurySpends) {
+	keeper.IterateTreasurySpends(ctx, func(spend types.TreasurySpend) bool {
+		spends = append(spends, spend)
+		return false
+	})
+	return
+}
+
+// IterateTreasurySpends iterates over the treasury spend history and performs a callback function
+func (keeper Keeper) IterateTreasurySpends(ctx sdk.Context, cb func(spend types.TreasurySpend) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TreasurySpendsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.TreasurySpend
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &spend)
+
+		if cb(spend) {
+			break
+		}
+	}
+}
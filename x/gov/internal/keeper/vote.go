@@ -7,7 +7,8 @@ import (
 	"strconv"
 )
 
-// AddVote adds a vote on a specific proposal
+// AddVote adds a vote on a specific proposal. A voter may change its vote until the voting ends,
+// once the params are initialized the votes are accepted only before the VotingEndBlock of the proposal.
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.ValAddress, option types.VoteOption) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -16,21 +17,31 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.V
 	if proposal.Status != types.StatusVotingPeriod {
 		return types.ErrInactiveProposal(strconv.FormatInt(int64(proposalID), 10))
 	}
+	if keeper.IsParamsInitialized(ctx) && ctx.BlockHeight() >= int64(proposal.VotingEndBlock) {
+		return types.ErrInactiveProposal(strconv.FormatInt(int64(proposalID), 10))
+	}
 
 	if !types.ValidVoteOption(option) {
 		return types.ErrInvalidVote(option.String())
 	}
+	if option == types.OptionNoWithVeto && !keeper.IsParamsInitialized(ctx) {
+		return types.ErrInvalidVote(option.String())
+	}
+
+	previousVote, changed := keeper.GetVote(ctx, proposalID, voterAddr)
 
 	vote := types.NewVote(proposalID, voterAddr, option)
 	keeper.SetVote(ctx, vote)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeProposalVote,
+		sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 	)
+	if changed && keeper.IsParamsInitialized(ctx) {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyPreviousOption, previousVote.Option.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
 
 	return nil
 }
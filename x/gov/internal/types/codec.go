@@ -10,6 +10,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
 	cdc.RegisterConcrete(MsgSoftwareUpgradeProposal{}, "cosmos-sdk/MsgSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgCancelSoftwareUpgradeProposal{}, "cosmos-sdk/MsgCancelSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(MsgReplaceSoftwareUpgradeProposal{}, "cosmos-sdk/MsgReplaceSoftwareUpgradeProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
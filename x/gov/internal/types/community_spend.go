@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunitySpend defines a transfer from the treasury to a recipient made when the proposal passes
+type CommunitySpend struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewCommunitySpend creates a new CommunitySpend instance
+func NewCommunitySpend(recipient sdk.AccAddress, amount sdk.Coins) CommunitySpend {
+	return CommunitySpend{
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// String implements the Stringer interface
+func (cs CommunitySpend) String() string {
+	return fmt.Sprintf(`Community Spend:
+  Recipient: %s
+  Amount:    %s`,
+		cs.Recipient, cs.Amount,
+	)
+}
+
+// ValidateCommunitySpends performs basic validation of community spends.
+// The treasury balance is checked when the proposal passes.
+func ValidateCommunitySpends(spends []CommunitySpend) error {
+	for _, spend := range spends {
+		if spend.Recipient.Empty() {
+			return ErrInvalidCommunitySpend("recipient cannot be blank")
+		}
+		if !spend.Amount.IsValid() || spend.Amount.Empty() {
+			return ErrInvalidCommunitySpend(fmt.Sprintf("invalid amount %s", spend.Amount))
+		}
+	}
+
+	return nil
+}
+
+// TreasurySpend records the community spends made by a passed proposal
+type TreasurySpend struct {
+	ProposalID uint64           `json:"proposal_id" yaml:"proposal_id"`
+	Height     int64            `json:"height" yaml:"height"`
+	Spends     []CommunitySpend `json:"spends" yaml:"spends"`
+}
+
+// NewTreasurySpend creates a new TreasurySpend instance
+func NewTreasurySpend(proposalID uint64, height int64, spends []CommunitySpend) TreasurySpend {
+	return TreasurySpend{
+		ProposalID: proposalID,
+		Height:     height,
+		Spends:     spends,
+	}
+}
+
+// String implements the Stringer interface
+func (ts TreasurySpend) String() string {
+	out := fmt.Sprintf("Treasury Spend of Proposal %d at height %d:", ts.ProposalID, ts.Height)
+	for _, spend := range ts.Spends {
+		out += fmt.Sprintf("\n  %s: %s", spend.Recipient, spend.Amount)
+	}
+	return out
+}
+
+// TreasurySpends is a collection of TreasurySpend objects
+type TreasurySpends []TreasurySpend
+
+// String implements the Stringer interface
+func (ts TreasurySpends) String() string {
+	if len(ts) == 0 {
+		return "[]"
+	}
+	out := ""
+	for _, spend := range ts {
+		out += spend.String() + "\n"
+	}
+	return out[:len(out)-1]
+}
@@ -13,12 +13,16 @@ const (
 )
 
 type Content struct {
-	Title       string `json:"title" yaml:"title"`             // Proposal title
-	Description string `json:"description" yaml:"description"` // Proposal description
+	Title       string           `json:"title" yaml:"title"`                         // Proposal title
+	Description string           `json:"description" yaml:"description"`             // Proposal description
+	Changes     []ParamChange    `json:"changes,omitempty" yaml:"changes,omitempty"` // Parameter changes applied when the proposal passes
+	Spends      []CommunitySpend `json:"spends,omitempty" yaml:"spends,omitempty"`   // Transfers from the treasury made when the proposal passes
 }
 
-func (c *Content) GetTitle() string       { return c.Title }
-func (c *Content) GetDescription() string { return c.Description }
+func (c *Content) GetTitle() string            { return c.Title }
+func (c *Content) GetDescription() string      { return c.Description }
+func (c *Content) GetChanges() []ParamChange   { return c.Changes }
+func (c *Content) GetSpends() []CommunitySpend { return c.Spends }
 
 // Handler defines a function that handles a proposal after it has passed the
 // governance process.
@@ -43,5 +47,9 @@ func Validate(c Content) error {
 		return ErrInvalidProposalContentDescrLong(strconv.Itoa(MaxDescriptionLength))
 	}
 
-	return nil
+	if err := ValidateParamChanges(c.GetChanges()); err != nil {
+		return err
+	}
+
+	return ValidateCommunitySpends(c.GetSpends())
 }
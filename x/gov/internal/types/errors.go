@@ -36,6 +36,13 @@ const (
 	CodeStartBlock              CodeType = 1100
 	CodeDurationTooLong         CodeType = 1200
 	CodeNotAllowed              CodeType = 1300
+	CodeInvalidParamChange      CodeType = 1400
+	CodeInvalidDeposit          CodeType = 1500
+	CodeInactiveDepositPeriod   CodeType = 1600
+	CodeDepositPeriodTooLong    CodeType = 1700
+	CodeInvalidCommunitySpend   CodeType = 1800
+	CodeInvalidUpgrade          CodeType = 1900
+	CodeInvalidReleaseManifest  CodeType = 2000
 )
 
 func ErrUnknownProposal(proposalID string) *sdkerrors.Error {
@@ -184,3 +191,68 @@ func ErrNotAllowed() *sdkerrors.Error {
 		"not allowed to create the proposal from this address",
 	)
 }
+
+func ErrInvalidParamChange(subspace, key, reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidParamChange,
+		fmt.Sprintf("invalid change of parameter %s/%s: %s", subspace, key, reason),
+		errors.NewParam("subspace", subspace),
+		errors.NewParam("key", key),
+		errors.NewParam("reason", reason),
+	)
+}
+
+func ErrInvalidDeposit(reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidDeposit,
+		fmt.Sprintf("invalid deposit: %s", reason),
+		errors.NewParam("reason", reason),
+	)
+}
+
+func ErrInactiveDepositPeriod(proposalID string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInactiveDepositPeriod,
+		fmt.Sprintf("proposal %s does not accept deposits anymore", proposalID),
+		errors.NewParam("proposalID", proposalID),
+	)
+}
+
+func ErrDepositPeriodTooLong(maxDepositPeriod string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeDepositPeriodTooLong,
+		fmt.Sprintf("voting of a proposal without whitelisted proposer must start within %s blocks", maxDepositPeriod),
+		errors.NewParam("maxDepositPeriod", maxDepositPeriod),
+	)
+}
+
+func ErrInvalidCommunitySpend(reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidCommunitySpend,
+		fmt.Sprintf("invalid community spend: %s", reason),
+		errors.NewParam("reason", reason),
+	)
+}
+
+func ErrInvalidUpgrade(reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidUpgrade,
+		fmt.Sprintf("invalid upgrade: %s", reason),
+		errors.NewParam("reason", reason),
+	)
+}
+
+func ErrInvalidReleaseManifest(reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidReleaseManifest,
+		fmt.Sprintf("invalid release manifest: %s", reason),
+		errors.NewParam("reason", reason),
+	)
+}
@@ -6,15 +6,22 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeParamChange      = "param_change"
+	EventTypeProposalDeposit  = "proposal_deposit"
+	EventTypeCommunitySpend   = "community_spend"
+	EventTypeCancelUpgrade    = "cancel_upgrade"
+	EventTypeReplaceUpgrade   = "replace_upgrade"
 
 	AttributeKeyProposalResult           = "proposal_result"
 	AttributeKeyOption                   = "option"
+	AttributeKeyPreviousOption           = "previous_option"
 	AttributeKeyProposalID               = "proposal_id"
 	AttributeKeyVotingPeriodStart        = "voting_period_start"
 	AttributeValueCategory               = "governance"
 	AttributeValueProposalPassed         = "proposal_passed"   // met vote quorum
 	AttributeValueProposalRejected       = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed         = "proposal_failed"   // error on proposal handler
+	AttributeValueProposalDropped        = "proposal_dropped"  // didn't meet min deposit before voting start
 	AttributeKeyProposalType             = "proposal_type"
 	AttributeKeyProposalTitle            = "proposal_title"
 	AttributeKeyProposalDescription      = "proposal_description"
@@ -24,6 +31,17 @@ const (
 	AttributeKeyResultVoteYes     = "result_vote_yes"
 	AttributeKeyResultVoteAbstain = "result_vote_abstain"
 	AttributeKeyResultVoteNo      = "result_vote_no"
+	AttributeKeyDepositsBurned    = "deposits_burned"
 	AttributeKeyTotalVotingPower  = "total_voting_power"
 	AttributeKeyUpgradeHeight     = "upgrade_height"
+	AttributeKeyUpgradeName       = "upgrade_name"
+	AttributeKeyPreviousHeight    = "previous_upgrade_height"
+	AttributeKeyPreviousName      = "previous_upgrade_name"
+
+	AttributeKeyParamSubspace = "param_subspace"
+	AttributeKeyParamKey      = "param_key"
+	AttributeKeyParamValue    = "param_value"
+
+	AttributeKeyAmount    = "amount"
+	AttributeKeyRecipient = "recipient"
 )
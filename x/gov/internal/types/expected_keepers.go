@@ -4,15 +4,23 @@ import (
 	valexported "bitbucket.org/decimalteam/go-node/x/validator/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
+// ParamsKeeper defines the expected params keeper to change params of all modules (noalias)
+type ParamsKeeper interface {
+	GetSubspace(name string) (params.Subspace, bool)
+}
+
 // SupplyKeeper defines the expected supply keeper for module accounts (noalias)
 type SupplyKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
@@ -39,7 +47,14 @@ type ValidatorKeeper interface {
 		fn func(index int64, delegation valexported.DelegationI) (stop bool),
 	)
 
+	IterateDelegatorDelegations(
+		ctx sdk.Context, delegator sdk.AccAddress,
+		cb func(delegation valexported.DelegationI) (stop bool),
+	)
+	TokenBaseOfDelegation(ctx sdk.Context, del valexported.DelegationI) sdk.Int // value of a custom coin delegation in base coin
+
 	HasValidator(sdk.Context, sdk.ValAddress) bool
+	BondDenom(sdk.Context) string
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -8,17 +8,22 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64      `json:"starting_proposal_id" yaml:"starting_proposal_id"`
-	Votes              Votes       `json:"votes" yaml:"votes"`
-	Proposals          Proposals   `json:"proposals" yaml:"proposals"`
-	TallyParams        TallyParams `json:"tally_params" yaml:"tally_params"`
+	StartingProposalID uint64         `json:"starting_proposal_id" yaml:"starting_proposal_id"`
+	Votes              Votes          `json:"votes" yaml:"votes"`
+	Proposals          Proposals      `json:"proposals" yaml:"proposals"`
+	TallyParams        TallyParams    `json:"tally_params" yaml:"tally_params"`
+	Deposits           Deposits       `json:"deposits,omitempty" yaml:"deposits,omitempty"`
+	DepositParams      DepositParams  `json:"deposit_params,omitempty" yaml:"deposit_params,omitempty"`
+	TreasurySpends     TreasurySpends `json:"treasury_spends,omitempty" yaml:"treasury_spends,omitempty"`
+	ReleaseParams      ReleaseParams  `json:"release_params,omitempty" yaml:"release_params,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, tp TallyParams) GenesisState {
+func NewGenesisState(startingProposalID uint64, tp TallyParams, dp DepositParams) GenesisState {
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		TallyParams:        tp,
+		DepositParams:      dp,
 	}
 }
 
@@ -27,6 +32,7 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultStartingProposalID,
 		DefaultTallyParams(),
+		DefaultDepositParams(),
 	)
 }
 
@@ -50,5 +56,40 @@ func ValidateGenesis(data GenesisState) error {
 			threshold.String())
 	}
 
+	if err := validateTallyParams(data.TallyParams); err != nil {
+		return err
+	}
+
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
+	}
+
+	if err := validateReleaseParams(data.ReleaseParams); err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]Proposal, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		proposals[proposal.ProposalID] = proposal
+	}
+	for _, deposit := range data.Deposits {
+		proposal, ok := proposals[deposit.ProposalID]
+		if !ok {
+			return fmt.Errorf("deposit of %s refers to unknown proposal %d", deposit.Depositor, deposit.ProposalID)
+		}
+		if proposal.Status != StatusDepositPeriod && proposal.Status != StatusWaiting && proposal.Status != StatusVotingPeriod {
+			return fmt.Errorf("deposit of %s refers to finished proposal %d", deposit.Depositor, deposit.ProposalID)
+		}
+		if !deposit.Amount.IsValid() {
+			return fmt.Errorf("invalid deposit of %s on proposal %d: %s", deposit.Depositor, deposit.ProposalID, deposit.Amount)
+		}
+	}
+
+	for _, spend := range data.TreasurySpends {
+		if err := ValidateCommunitySpends(spend.Spends); err != nil {
+			return fmt.Errorf("invalid treasury spend of proposal %d: %w", spend.ProposalID, err)
+		}
+	}
+
 	return nil
 }
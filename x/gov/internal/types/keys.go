@@ -59,6 +59,12 @@ var (
 // - gov/proposals/next: nextProposalID
 //
 // - gov/votes/<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - gov/deposits/<proposalID_Bytes><depositorAddr_Bytes>: Deposit
+//
+// - gov/treasury/spends/<proposalID_Bytes>: TreasurySpend
+//
+// - gov/cancelled/<height_Bytes><planName>: CancelledPlan
 var (
 	ProposalsKeyPrefix          = []byte("gov/proposals/")
 	ActiveProposalQueuePrefix   = []byte("gov/proposals/active/")
@@ -67,8 +73,14 @@ var (
 
 	VotesKeyPrefix = []byte("gov/votes/")
 
+	DepositsKeyPrefix = []byte("gov/deposits/")
+
+	TreasurySpendsKeyPrefix = []byte("gov/treasury/spends/")
+
 	PlanPrefix = []byte("gov/plan")
 	DonePrefix = []byte("gov/done")
+
+	CancelledPlansKeyPrefix = []byte("gov/cancelled/")
 )
 
 // GetProposalIDBytes returns the byte representation of the proposalID
@@ -128,6 +140,21 @@ func VoteKey(proposalID uint64, voterAddr sdk.ValAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// DepositsKey gets the first part of the deposits key based on the proposalID
+func DepositsKey(proposalID uint64) []byte {
+	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// TreasurySpendKey gets the key of the treasury spend made by a specific proposal
+func TreasurySpendKey(proposalID uint64) []byte {
+	return append(TreasurySpendsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// DepositKey key of a specific deposit from the store
+func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
+	return append(DepositsKey(proposalID), depositorAddr.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -173,6 +200,11 @@ func DoneKey() []byte {
 	return DonePrefix
 }
 
+// CancelledPlanKey is the key at which the plan cancelled at the given height is saved
+func CancelledPlanKey(height int64, name string) []byte {
+	return append(append(CancelledPlansKeyPrefix, GetBytesFromUint64(uint64(height))...), name...)
+}
+
 func UpgradedClientKey(height int64) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedClient))
 }
@@ -11,12 +11,15 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgVote            = "vote"
-	TypeMsgSubmitProposal  = "submit_proposal"
-	TypeMsgSoftwareUpgrade = "software_upgrade"
+	TypeMsgVote                   = "vote"
+	TypeMsgSubmitProposal         = "submit_proposal"
+	TypeMsgSoftwareUpgrade        = "software_upgrade"
+	TypeMsgCancelSoftwareUpgrade  = "cancel_software_upgrade"
+	TypeMsgReplaceSoftwareUpgrade = "replace_software_upgrade"
+	TypeMsgDeposit                = "deposit"
 )
 
-var _, _ sdk.Msg = MsgSubmitProposal{}, MsgVote{}
+var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgVote{}, MsgDeposit{}
 
 // MsgSubmitProposal defines a message to create a governance proposal with a
 // given content and initial deposit
@@ -25,6 +28,7 @@ type MsgSubmitProposal struct {
 	Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"` //  Address of the proposer
 	VotingStartBlock uint64         `json:"voting_start_block" yaml:"voting_start_block"`
 	VotingEndBlock   uint64         `json:"voting_end_block" yaml:"voting_end_block"`
+	InitialDeposit   sdk.Coins      `json:"initial_deposit,omitempty" yaml:"initial_deposit,omitempty"` //  Initial deposit paid by the proposer
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
@@ -37,6 +41,12 @@ func NewMsgSubmitProposal(content Content, proposer sdk.AccAddress, votingStartB
 	}
 }
 
+// WithInitialDeposit sets the deposit paid by the proposer on submission
+func (msg MsgSubmitProposal) WithInitialDeposit(initialDeposit sdk.Coins) MsgSubmitProposal {
+	msg.InitialDeposit = initialDeposit
+	return msg
+}
+
 // Route implements Msg
 func (msg MsgSubmitProposal) Route() string { return RouterKey }
 
@@ -61,7 +71,15 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 		return ErrDurationTooLong()
 	}
 
-	return nil
+	if !msg.InitialDeposit.IsValid() {
+		return ErrInvalidDeposit(msg.InitialDeposit.String())
+	}
+
+	if err := ValidateParamChanges(msg.Content.Changes); err != nil {
+		return err
+	}
+
+	return ValidateCommunitySpends(msg.Content.Spends)
 }
 
 // String implements the Stringer interface
@@ -69,7 +87,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Title:          %s
   Description:    %s
-`, msg.Content.Title, msg.Content.Description)
+  Initial Deposit: %s
+`, msg.Content.Title, msg.Content.Description, msg.InitialDeposit)
 }
 
 // GetSignBytes implements Msg
@@ -132,6 +151,56 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Voter)}
 }
 
+// MsgDeposit defines a message to add a deposit to a proposal waiting for the minimum deposit
+type MsgDeposit struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`     // Address of the depositor
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`           // Coins to add to the proposal's deposit
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance
+func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) MsgDeposit {
+	return MsgDeposit{proposalID, depositor, amount}
+}
+
+// Route implements Msg
+func (msg MsgDeposit) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDeposit) Type() string { return TypeMsgDeposit }
+
+// ValidateBasic implements Msg
+func (msg MsgDeposit) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Depositor.String())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return ErrInvalidDeposit(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgDeposit) String() string {
+	return fmt.Sprintf(`Deposit Message:
+  Depositor:   %s
+  Proposal ID: %d
+  Amount:      %s
+`, msg.Depositor, msg.ProposalID, msg.Amount)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
 const AddressForSoftwareUpgrade = "dx15gflwp3lj4neh2gt9p4ljrv9vtlda836xj7kev"
 
 // Software Upgrade Proposals
@@ -154,15 +223,7 @@ func (msg MsgSoftwareUpgradeProposal) Type() string { return TypeMsgSoftwareUpgr
 
 // ValidateBasic implements Msg
 func (msg MsgSoftwareUpgradeProposal) ValidateBasic() error {
-	address, err := sdk.AccAddressFromBech32(AddressForSoftwareUpgrade)
-	if err != nil {
-		return err
-	}
-	// ADD NOT
-	if !msg.Proposer.Equals(address) {
-		return errors.New("not allowed")
-	}
-	return nil
+	return validateUpgradeProposer(msg.Proposer)
 }
 
 // String implements the Stringer interface
@@ -183,3 +244,102 @@ func (msg MsgSoftwareUpgradeProposal) GetSignBytes() []byte {
 func (msg MsgSoftwareUpgradeProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
 }
+
+// MsgCancelSoftwareUpgradeProposal clears the scheduled upgrade plan
+type MsgCancelSoftwareUpgradeProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"` //  Address of the proposer
+}
+
+func NewCancelSoftwareUpgradeProposal(title, description string, proposer sdk.AccAddress) MsgCancelSoftwareUpgradeProposal {
+	return MsgCancelSoftwareUpgradeProposal{title, description, proposer}
+}
+
+// Route implements Msg
+func (msg MsgCancelSoftwareUpgradeProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelSoftwareUpgradeProposal) Type() string { return TypeMsgCancelSoftwareUpgrade }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelSoftwareUpgradeProposal) ValidateBasic() error {
+	return validateUpgradeProposer(msg.Proposer)
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Message:
+  Title:          %s
+  Description:    %s
+`, msg.Title, msg.Description)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelSoftwareUpgradeProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelSoftwareUpgradeProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgReplaceSoftwareUpgradeProposal reschedules the scheduled upgrade to a new height or binary
+type MsgReplaceSoftwareUpgradeProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Plan        Plan           `json:"plan" yaml:"plan"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"` //  Address of the proposer
+}
+
+func NewReplaceSoftwareUpgradeProposal(title, description string, plan Plan, proposer sdk.AccAddress) MsgReplaceSoftwareUpgradeProposal {
+	return MsgReplaceSoftwareUpgradeProposal{title, description, plan, proposer}
+}
+
+// Route implements Msg
+func (msg MsgReplaceSoftwareUpgradeProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgReplaceSoftwareUpgradeProposal) Type() string { return TypeMsgReplaceSoftwareUpgrade }
+
+// ValidateBasic implements Msg
+func (msg MsgReplaceSoftwareUpgradeProposal) ValidateBasic() error {
+	if err := validateUpgradeProposer(msg.Proposer); err != nil {
+		return err
+	}
+	return msg.Plan.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (msg MsgReplaceSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Replace Software Upgrade Message:
+  Title:          %s
+  Description:    %s
+  %s
+`, msg.Title, msg.Description, msg.Plan)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgReplaceSoftwareUpgradeProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgReplaceSoftwareUpgradeProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// validateUpgradeProposer checks that only the upgrade authority schedules, cancels and replaces upgrades
+func validateUpgradeProposer(proposer sdk.AccAddress) error {
+	address, err := sdk.AccAddressFromBech32(AddressForSoftwareUpgrade)
+	if err != nil {
+		return err
+	}
+	if !proposer.Equals(address) {
+		return errors.New("not allowed")
+	}
+	return nil
+}
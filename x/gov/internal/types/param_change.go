@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParamChange defines a change of a module parameter: the new JSON encoded value of the key in the params subspace
+type ParamChange struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Value    string `json:"value" yaml:"value"`
+}
+
+// NewParamChange creates a new ParamChange instance
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Value:    value,
+	}
+}
+
+// String implements the Stringer interface
+func (pc ParamChange) String() string {
+	return fmt.Sprintf(`Param Change:
+  Subspace: %s
+  Key:      %s
+  Value:    %s`,
+		pc.Subspace, pc.Key, pc.Value,
+	)
+}
+
+// ValidateParamChanges performs basic validation of parameter changes.
+// Values are validated by the params subspaces when the proposal is submitted.
+func ValidateParamChanges(changes []ParamChange) error {
+	seen := make(map[string]bool)
+	for _, change := range changes {
+		if len(strings.TrimSpace(change.Subspace)) == 0 {
+			return ErrInvalidParamChange(change.Subspace, change.Key, "subspace cannot be blank")
+		}
+		if len(strings.TrimSpace(change.Key)) == 0 {
+			return ErrInvalidParamChange(change.Subspace, change.Key, "key cannot be blank")
+		}
+		if len(strings.TrimSpace(change.Value)) == 0 {
+			return ErrInvalidParamChange(change.Subspace, change.Key, "value cannot be blank")
+		}
+
+		id := change.Subspace + "/" + change.Key
+		if seen[id] {
+			return ErrInvalidParamChange(change.Subspace, change.Key, "duplicate change")
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
@@ -1,54 +1,204 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"bitbucket.org/decimalteam/go-node/utils/helpers"
+	"bitbucket.org/decimalteam/go-node/x/validator"
 )
 
 // Default governance params
 var (
 	DefaultQuorum    = sdk.NewDecWithPrec(667, 3)
 	DefaultThreshold = sdk.NewDecWithPrec(5, 1)
+	DefaultVeto      = sdk.NewDecWithPrec(334, 3)
+
+	DefaultMaxValidators    = uint64(9) // the tally counted the validators before index 9 since Update1Block
+	DefaultNonVotersAbstain = true
+
+	DefaultMinDepositAmount = helpers.BipToPip(sdk.NewInt(1000))
+	DefaultMaxDepositPeriod = uint64(100800) // about one week
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyTallyParams = []byte("tallyparams")
+	ParamStoreKeyTallyParams   = []byte("tallyparams")
+	ParamStoreKeyDepositParams = []byte("depositparams")
+	ParamStoreKeyReleaseParams = []byte("releaseparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		params.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		params.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		params.NewParamSetPair(ParamStoreKeyReleaseParams, ReleaseParams{}, validateReleaseParams),
+	)
+}
+
+// DepositParams defines the params around deposits for governance
+type DepositParams struct {
+	MinDeposit       sdk.Coins `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod uint64    `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum number of blocks between submission and VotingStartBlock of a proposal without a whitelisted proposer
+	AllowedProposers []string  `json:"allowed_proposers,omitempty" yaml:"allowed_proposers,omitempty"`   //  Addresses allowed to submit proposals without a deposit
+}
+
+// NewDepositParams creates a new DepositParams object
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod uint64, allowedProposers []string) DepositParams {
+	return DepositParams{
+		MinDeposit:       minDeposit,
+		MaxDepositPeriod: maxDepositPeriod,
+		AllowedProposers: allowedProposers,
+	}
+}
+
+// DefaultDepositParams default parameters for deposits
+func DefaultDepositParams() DepositParams {
+	return NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(validator.DefaultBondDenom, DefaultMinDepositAmount)),
+		DefaultMaxDepositPeriod,
+		AllowedAddresses,
 	)
 }
 
+// IsEmpty returns true if none of the deposit params are set
+func (dp DepositParams) IsEmpty() bool {
+	return dp.MinDeposit.Empty() && dp.MaxDepositPeriod == 0 && len(dp.AllowedProposers) == 0
+}
+
+// IsAllowedProposer returns true if the address may submit proposals without a deposit
+func (dp DepositParams) IsAllowedProposer(address sdk.AccAddress) bool {
+	for _, allowedProposer := range dp.AllowedProposers {
+		if allowedProposer == address.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements stringer insterface
+func (dp DepositParams) String() string {
+	return fmt.Sprintf(`Deposit Params:
+  Min Deposit:        %s
+  Max Deposit Period: %d
+  Allowed Proposers:  %s`,
+		dp.MinDeposit, dp.MaxDepositPeriod, strings.Join(dp.AllowedProposers, ", "))
+}
+
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", v.MinDeposit)
+	}
+	if !v.MinDeposit.Empty() && v.MaxDepositPeriod == 0 {
+		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
+	}
+	if v.MaxDepositPeriod > uint64(DurationInBlocks) {
+		return fmt.Errorf("maximum deposit period too large: %d", v.MaxDepositPeriod)
+	}
+	for _, allowedProposer := range v.AllowedProposers {
+		if _, err := sdk.AccAddressFromBech32(allowedProposer); err != nil {
+			return fmt.Errorf("invalid allowed proposer %s: %w", allowedProposer, err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseParams defines the keys signing the release manifests of software upgrades
+type ReleaseParams struct {
+	Keys      []string `json:"keys,omitempty" yaml:"keys,omitempty"`           //  Hex encoded ed25519 public keys allowed to sign release manifests
+	Threshold uint64   `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum number of the keys signing the release manifest of an upgrade plan. Manifests are not required if zero
+}
+
+// NewReleaseParams creates a new ReleaseParams object
+func NewReleaseParams(keys []string, threshold uint64) ReleaseParams {
+	return ReleaseParams{
+		Keys:      keys,
+		Threshold: threshold,
+	}
+}
+
+// DefaultReleaseParams default parameters for release manifests
+func DefaultReleaseParams() ReleaseParams {
+	return NewReleaseParams(nil, 0)
+}
+
+// String implements stringer insterface
+func (rp ReleaseParams) String() string {
+	return fmt.Sprintf(`Release Params:
+  Keys:      %s
+  Threshold: %d`,
+		strings.Join(rp.Keys, ", "), rp.Threshold)
+}
+
+func validateReleaseParams(i interface{}) error {
+	v, ok := i.(ReleaseParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	keys := make(map[string]bool)
+	for _, key := range v.Keys {
+		if bz, err := hex.DecodeString(key); err != nil || len(bz) != ed25519.PubKeyEd25519Size {
+			return fmt.Errorf("invalid release key: %s", key)
+		}
+		if keys[strings.ToLower(key)] {
+			return fmt.Errorf("duplicate release key: %s", key)
+		}
+		keys[strings.ToLower(key)] = true
+	}
+	if v.Threshold > uint64(len(v.Keys)) {
+		return fmt.Errorf("release threshold %d exceeds the number of release keys %d", v.Threshold, len(v.Keys))
+	}
+
+	return nil
+}
+
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Quorum           sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                         //  Minimum percentage of counted stake needed to vote Yes for proposal to pass, and to vote at all for deposits to be refunded
+	Threshold        sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                   //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto             sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                             //  Minimum proportion of NoWithVeto votes for deposits to be burned. Initial value: 0.334
+	MaxValidators    uint64  `json:"max_validators,omitempty" yaml:"max_validators,omitempty"`         //  Number of the validators with the most power whose votes are counted. Initial value: 9
+	NonVotersAbstain bool    `json:"non_voters_abstain,omitempty" yaml:"non_voters_abstain,omitempty"` //  Whether the counted validators who don't vote are counted as abstaining. Initial value: true
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto sdk.Dec, maxValidators uint64, nonVotersAbstain bool) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
+		Quorum:           quorum,
+		Threshold:        threshold,
+		Veto:             veto,
+		MaxValidators:    maxValidators,
+		NonVotersAbstain: nonVotersAbstain,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultMaxValidators, DefaultNonVotersAbstain)
 }
 
 // String implements stringer insterface
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
   Quorum:             %s
-  Threshold:          %s`,
-		tp.Quorum, tp.Threshold)
+  Threshold:          %s
+  Veto:               %s
+  Max Validators:     %d
+  Non-Voters Abstain: %t`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.MaxValidators, tp.NonVotersAbstain)
 }
 
 func validateTallyParams(i interface{}) error {
@@ -69,27 +219,37 @@ func validateTallyParams(i interface{}) error {
 	if v.Threshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("vote threshold too large: %s", v)
 	}
+	if !v.Veto.IsNil() && !v.Veto.IsPositive() {
+		return fmt.Errorf("veto threshold must be positive: %s", v.Veto)
+	}
+	if !v.Veto.IsNil() && v.Veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // Params returns all of the governance params
 type Params struct {
-	TallyParams TallyParams `json:"tally_params" yaml:"tally_params"`
+	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
+	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	ReleaseParams ReleaseParams `json:"release_params" yaml:"release_params"`
 }
 
 func (gp Params) String() string {
-	return gp.TallyParams.String()
+	return gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" + gp.ReleaseParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(tp TallyParams) Params {
+func NewParams(tp TallyParams, dp DepositParams, rp ReleaseParams) Params {
 	return Params{
-		TallyParams: tp,
+		TallyParams:   tp,
+		DepositParams: dp,
+		ReleaseParams: rp,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultTallyParams())
+	return NewParams(DefaultTallyParams(), DefaultDepositParams(), DefaultReleaseParams())
 }
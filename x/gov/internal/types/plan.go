@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
@@ -52,6 +53,26 @@ func (p Plan) Mapping() map[string][]string {
 	return mapping
 }
 
+// ReleaseManifest returns the release manifest put into the plan info, if any
+func (p Plan) ReleaseManifest() (ReleaseManifest, bool) {
+	manifest, err := ParseReleaseManifest([]byte(p.Info))
+	return manifest, err == nil
+}
+
+// Hashes returns the sha256 hashes of the binaries with the names built for the OS. They are taken
+// either from the release manifest or from the legacy mapping of the OS to the hashes in the plan info.
+func (p Plan) Hashes(osArch string, names []string) ([]string, bool) {
+	if manifest, ok := p.ReleaseManifest(); ok {
+		return manifest.Hashes(osArch, names)
+	}
+
+	hashes, ok := p.Mapping()[osArch]
+	if !ok || len(hashes) < len(names) {
+		return nil, false
+	}
+	return hashes[:len(names)], true
+}
+
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
 	if len(p.Name) == 0 {
@@ -92,7 +113,53 @@ func (p Plan) DueAt() string {
 	return fmt.Sprintf("height: %d", p.Height)
 }
 
+// Release returns the release of the plan, the last element of the plan name. The binaries of the
+// plan are downloaded from the directory of the release.
+func (p Plan) Release() string {
+	return path.Base(p.Name)
+}
+
+// UpgradeHandler migrates the store at the height the plan of its release executes
+type UpgradeHandler func(ctx sdk.Context, plan Plan) error
+
 // UpgradeConfig is expected format for the info field to allow auto-download
 type UpgradeConfig struct {
 	Binaries map[string]string `json:"binaries"`
 }
+
+// CancelledPlan records a scheduled plan that was cancelled or replaced before its execution
+type CancelledPlan struct {
+	Plan       Plan   `json:"plan" yaml:"plan"`
+	Height     int64  `json:"height" yaml:"height"`                               // Height at which the plan was cancelled
+	ReplacedBy string `json:"replaced_by,omitempty" yaml:"replaced_by,omitempty"` // Name of the plan scheduled instead, if any
+}
+
+// NewCancelledPlan creates a new CancelledPlan instance
+func NewCancelledPlan(plan Plan, height int64, replacedBy string) CancelledPlan {
+	return CancelledPlan{
+		Plan:       plan,
+		Height:     height,
+		ReplacedBy: replacedBy,
+	}
+}
+
+func (cp CancelledPlan) String() string {
+	if cp.ReplacedBy != "" {
+		return fmt.Sprintf("%s\n  Replaced by \"%s\" at height: %d", cp.Plan, cp.ReplacedBy, cp.Height)
+	}
+	return fmt.Sprintf("%s\n  Cancelled at height: %d", cp.Plan, cp.Height)
+}
+
+// CancelledPlans is a collection of CancelledPlan objects
+type CancelledPlans []CancelledPlan
+
+func (cps CancelledPlans) String() string {
+	if len(cps) == 0 {
+		return "[]"
+	}
+	out := make([]string, len(cps))
+	for i, cp := range cps {
+		out[i] = cp.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -40,6 +40,10 @@ type Proposal struct {
 
 	VotingStartBlock uint64 `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndBlock   uint64 `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	TotalDeposit sdk.Coins `json:"total_deposit,omitempty" yaml:"total_deposit,omitempty"` // Current deposit on this proposal
+
+	Proposer sdk.AccAddress `json:"proposer,omitempty" yaml:"proposer,omitempty"` // Address of the proposer, kept once the gov params are initialized
 }
 
 // NewProposal creates a new Proposal instance
@@ -56,15 +60,26 @@ func NewProposal(content Content, id, votingStartBlock, VotingEndBlock uint64) P
 
 // String implements stringer interface
 func (p Proposal) String() string {
-	return fmt.Sprintf(`Proposal %d:
+	out := fmt.Sprintf(`Proposal %d:
   Title:              %s
   Status:             %s
   Voting Start Time:  %d
   Voting End Time:    %d
+  Total Deposit:      %s
   Description:        %s`,
 		p.ProposalID, p.Title,
-		p.Status, p.VotingStartBlock, p.VotingEndBlock, p.Description,
+		p.Status, p.VotingStartBlock, p.VotingEndBlock, p.TotalDeposit, p.Description,
 	)
+	if !p.Proposer.Empty() {
+		out += fmt.Sprintf("\n  Proposer:           %s", p.Proposer)
+	}
+	for _, change := range p.Changes {
+		out += "\n" + change.String()
+	}
+	for _, spend := range p.Spends {
+		out += "\n" + spend.String()
+	}
+	return out
 }
 
 // Proposals is an array of proposal
@@ -96,6 +111,8 @@ const (
 	StatusPassed       ProposalStatus = 0x03
 	StatusRejected     ProposalStatus = 0x04
 	StatusFailed       ProposalStatus = 0x05
+	// StatusDepositPeriod is the status of a proposal waiting for the minimum deposit before its VotingStartBlock
+	StatusDepositPeriod ProposalStatus = 0x06
 )
 
 // ProposalStatusFromString turns a string into a ProposalStatus
@@ -116,6 +133,9 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 	case "Failed":
 		return StatusFailed, nil
 
+	case "DepositPeriod":
+		return StatusDepositPeriod, nil
+
 	case "":
 		return StatusNil, nil
 
@@ -131,7 +151,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusDepositPeriod {
 		return true
 	}
 	return false
@@ -188,6 +209,9 @@ func (status ProposalStatus) String() string {
 	case StatusFailed:
 		return "Failed"
 
+	case StatusDepositPeriod:
+		return "DepositPeriod"
+
 	default:
 		return ""
 	}
@@ -12,16 +12,30 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+	QueryBreakdown = "breakdown"
+	QueryTreasury  = "treasury"
+	QuerySpends    = "spends"
+
+	QueryVotableProposals = "votable_proposals"
+
+	QueryCurrentPlan    = "current_plan"
+	QueryAppliedPlan    = "applied_plan"
+	QueryCancelledPlans = "cancelled_plans"
 
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamRelease  = "release"
+
+	// StatusInactive filters the proposals pending their voting start block, both waiting and in deposit period
+	StatusInactive = "inactive"
 )
 
 // QueryProposalParams Params for queries:
 // - 'custom/gov/proposal'
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
+// - 'custom/gov/breakdown'
 type QueryProposalParams struct {
 	ProposalID uint64
 }
@@ -84,6 +98,10 @@ type QueryProposalsParams struct {
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
 	ProposalStatus ProposalStatus
+	Inactive       bool           // match the proposals pending their voting start block
+	Proposer       sdk.AccAddress // match the proposals submitted by the proposer once the gov params are initialized
+	FromBlock      uint64         // match the proposals whose voting ends at or after the block
+	ToBlock        uint64         // match the proposals whose voting starts at or before the block
 }
 
 // NewQueryProposalsParams creates a new instance of QueryProposalsParams
@@ -96,3 +114,45 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+// QueryVotableProposalsParams Params for query 'custom/gov/votable_proposals'
+type QueryVotableProposalsParams struct {
+	Validator sdk.ValAddress
+	Page      int
+	Limit     int
+}
+
+// NewQueryVotableProposalsParams creates a new instance of QueryVotableProposalsParams
+func NewQueryVotableProposalsParams(validator sdk.ValAddress, page, limit int) QueryVotableProposalsParams {
+	return QueryVotableProposalsParams{
+		Validator: validator,
+		Page:      page,
+		Limit:     limit,
+	}
+}
+
+// QueryTreasurySpendsParams Params for query 'custom/gov/spends'
+type QueryTreasurySpendsParams struct {
+	Page  int
+	Limit int
+}
+
+// NewQueryTreasurySpendsParams creates a new instance of QueryTreasurySpendsParams
+func NewQueryTreasurySpendsParams(page, limit int) QueryTreasurySpendsParams {
+	return QueryTreasurySpendsParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// QueryAppliedPlanParams Params for query 'custom/gov/applied_plan'
+type QueryAppliedPlanParams struct {
+	Name string
+}
+
+// NewQueryAppliedPlanParams creates a new instance of QueryAppliedPlanParams
+func NewQueryAppliedPlanParams(name string) QueryAppliedPlanParams {
+	return QueryAppliedPlanParams{
+		Name: name,
+	}
+}
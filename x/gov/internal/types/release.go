@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// ReleaseBinary is a file of the release built for the OS
+type ReleaseBinary struct {
+	File   string `json:"file" yaml:"file"`
+	OSArch string `json:"os_arch" yaml:"os_arch"`
+	Hash   string `json:"sha256" yaml:"sha256"`
+}
+
+// ReleaseSignature is a signature of the release manifest by a release key, both hex encoded
+type ReleaseSignature struct {
+	PubKey    string `json:"pub_key" yaml:"pub_key"`
+	Signature string `json:"signature" yaml:"signature"`
+}
+
+// ReleaseManifest lists the binaries of the release the upgrade plan switches the nodes to.
+// The manifest is put into the info of the plan and is signed by the release keys set in the gov params.
+type ReleaseManifest struct {
+	Name       string             `json:"name" yaml:"name"` // Name of the plan the manifest is made for
+	Binaries   []ReleaseBinary    `json:"binaries" yaml:"binaries"`
+	Signatures []ReleaseSignature `json:"signatures,omitempty" yaml:"signatures,omitempty"`
+}
+
+// NewReleaseManifest creates a new unsigned ReleaseManifest instance
+func NewReleaseManifest(name string, binaries []ReleaseBinary) ReleaseManifest {
+	return ReleaseManifest{
+		Name:     name,
+		Binaries: binaries,
+	}
+}
+
+// ParseReleaseManifest decodes the release manifest from JSON
+func ParseReleaseManifest(bz []byte) (ReleaseManifest, error) {
+	var manifest ReleaseManifest
+	err := json.Unmarshal(bz, &manifest)
+	if err != nil {
+		return manifest, err
+	}
+	return manifest, manifest.ValidateBasic()
+}
+
+// ValidateBasic does basic validation of a ReleaseManifest
+func (m ReleaseManifest) ValidateBasic() error {
+	if len(m.Name) == 0 {
+		return fmt.Errorf("release name cannot be empty")
+	}
+	if len(m.Binaries) == 0 {
+		return fmt.Errorf("release has no binaries")
+	}
+
+	seen := make(map[string]bool)
+	for _, binary := range m.Binaries {
+		if len(binary.File) == 0 || len(binary.OSArch) == 0 {
+			return fmt.Errorf("file and os of a release binary cannot be empty")
+		}
+		if hash, err := hex.DecodeString(binary.Hash); err != nil || len(hash) != 32 {
+			return fmt.Errorf("invalid sha256 of %s for '%s': %s", binary.File, binary.OSArch, binary.Hash)
+		}
+		if seen[binary.OSArch+"/"+binary.File] {
+			return fmt.Errorf("duplicate binary %s for '%s'", binary.File, binary.OSArch)
+		}
+		seen[binary.OSArch+"/"+binary.File] = true
+	}
+
+	return nil
+}
+
+// SignBytes returns the bytes signed by the release keys: sorted JSON of the manifest without signatures
+func (m ReleaseManifest) SignBytes() []byte {
+	m.Signatures = nil
+	bz, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// Sign adds the signature of the manifest by the release key
+func (m *ReleaseManifest) Sign(privKey ed25519.PrivKeyEd25519) error {
+	sig, err := privKey.Sign(m.SignBytes())
+	if err != nil {
+		return err
+	}
+
+	pubKey := privKey.PubKey().(ed25519.PubKeyEd25519)
+	m.Signatures = append(m.Signatures, ReleaseSignature{
+		PubKey:    hex.EncodeToString(pubKey[:]),
+		Signature: hex.EncodeToString(sig),
+	})
+	return nil
+}
+
+// Verify checks that the manifest is signed by at least threshold of the release keys.
+// Signatures by other keys are not counted.
+func (m ReleaseManifest) Verify(keys []string, threshold uint64) error {
+	allowed := make(map[string]bool)
+	for _, key := range keys {
+		allowed[strings.ToLower(key)] = true
+	}
+
+	signBytes := m.SignBytes()
+	signed := make(map[string]bool)
+	for _, signature := range m.Signatures {
+		key := strings.ToLower(signature.PubKey)
+		if !allowed[key] || signed[key] {
+			continue
+		}
+
+		var pubKey ed25519.PubKeyEd25519
+		bz, err := hex.DecodeString(key)
+		if err != nil || len(bz) != len(pubKey) {
+			return fmt.Errorf("invalid release key: %s", signature.PubKey)
+		}
+		copy(pubKey[:], bz)
+
+		sig, err := hex.DecodeString(signature.Signature)
+		if err != nil || !pubKey.VerifyBytes(signBytes, sig) {
+			return fmt.Errorf("invalid signature by release key %s", signature.PubKey)
+		}
+		signed[key] = true
+	}
+
+	if uint64(len(signed)) < threshold {
+		return fmt.Errorf("release is signed by %d of %d required release keys", len(signed), threshold)
+	}
+	return nil
+}
+
+// Hashes returns the sha256 hashes of the files built for the OS in the order of the names
+func (m ReleaseManifest) Hashes(osArch string, names []string) ([]string, bool) {
+	hashes := make([]string, len(names))
+	for i, name := range names {
+		for _, binary := range m.Binaries {
+			if binary.OSArch == osArch && binary.File == name {
+				hashes[i] = binary.Hash
+			}
+		}
+		if hashes[i] == "" {
+			return nil, false
+		}
+	}
+	return hashes, true
+}
+
+func (m ReleaseManifest) String() string {
+	out := fmt.Sprintf("Release Manifest\n  Name: %s\n  Binaries:", m.Name)
+	for _, binary := range m.Binaries {
+		out += fmt.Sprintf("\n    %s %s %s", binary.OSArch, binary.File, binary.Hash)
+	}
+	out += "\n  Signed By:"
+	for _, signature := range m.Signatures {
+		out += fmt.Sprintf("\n    %s", signature.PubKey)
+	}
+	return out
+}
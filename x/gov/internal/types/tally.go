@@ -7,26 +7,92 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address      sdk.ValAddress // address of the validator operator
-	BondedTokens sdk.Int        // Power of a Validator
-	Vote         VoteOption     // Vote of the validator
+	Address             sdk.ValAddress // address of the validator operator
+	BondedTokens        sdk.Int        // Power of a Validator
+	DelegatorDeductions sdk.Int        // Stake of the delegators who voted themselves
+	Vote                VoteOption     // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, vote VoteOption) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
-		Address:      address,
-		BondedTokens: bondedTokens,
-		Vote:         vote,
+		Address:             address,
+		BondedTokens:        bondedTokens,
+		DelegatorDeductions: sdk.ZeroInt(),
+		Vote:                vote,
 	}
 }
 
+// DelegatorGovInfo used for tallying the vote of a delegator who overrides the votes of its validators
+type DelegatorGovInfo struct {
+	Address sdk.AccAddress // address of the delegator
+	Stake   sdk.Int        // Stake of the delegator in the counted validators, in base coin
+	Vote    VoteOption     // Vote of the delegator
+}
+
+// NewDelegatorGovInfo creates a DelegatorGovInfo instance
+func NewDelegatorGovInfo(address sdk.AccAddress, vote VoteOption) DelegatorGovInfo {
+	return DelegatorGovInfo{
+		Address: address,
+		Stake:   sdk.ZeroInt(),
+		Vote:    vote,
+	}
+}
+
+// ValidatorVote is the validator view of the votes on a proposal: the option counted for
+// the validator and its power left after the deductions of the delegators who voted themselves
+type ValidatorVote struct {
+	Validator  sdk.ValAddress `json:"validator" yaml:"validator"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+	Power      sdk.Int        `json:"power" yaml:"power"`
+	Deductions sdk.Int        `json:"deductions" yaml:"deductions"`
+}
+
+// DelegatorVote is the delegator view of the votes on a proposal: the option of a delegator
+// and the stake it moved away from the votes of its validators
+type DelegatorVote struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Option    VoteOption     `json:"option" yaml:"option"`
+	Power     sdk.Int        `json:"power" yaml:"power"`
+}
+
+// VoteBreakdown shows how the votes on a proposal are counted by both validators and delegators
+type VoteBreakdown struct {
+	ProposalID uint64           `json:"proposal_id" yaml:"proposal_id"`
+	Validators []ValidatorVote  `json:"validators" yaml:"validators"`
+	Delegators []DelegatorVote  `json:"delegators" yaml:"delegators"`
+	NonVoters  []sdk.ValAddress `json:"non_voters" yaml:"non_voters"` // counted validators who didn't vote
+	Abstained  bool             `json:"abstained" yaml:"abstained"`   // whether the non voters are counted as abstaining
+}
+
+// String implements stringer interface
+func (vb VoteBreakdown) String() string {
+	out := fmt.Sprintf("Vote Breakdown for Proposal %d:\n  Validators:", vb.ProposalID)
+	for _, val := range vb.Validators {
+		out += fmt.Sprintf("\n    %s: %s (power %s, deducted %s)", val.Validator, val.Option, val.Power, val.Deductions)
+	}
+	out += "\n  Delegators:"
+	for _, del := range vb.Delegators {
+		out += fmt.Sprintf("\n    %s: %s (power %s)", del.Delegator, del.Option, del.Power)
+	}
+	if vb.Abstained {
+		out += "\n  Did Not Vote (counted as abstaining):"
+	} else {
+		out += "\n  Did Not Vote (not counted):"
+	}
+	for _, val := range vb.NonVoters {
+		out += fmt.Sprintf("\n    %s", val)
+	}
+	return out
+}
+
 // TallyResult defines a standard tally for a proposal
 type TallyResult struct {
-	Yes     sdk.Int `json:"yes" yaml:"yes"`
-	Abstain sdk.Int `json:"abstain" yaml:"abstain"`
-	No      sdk.Int `json:"no" yaml:"no"`
+	Yes        sdk.Int          `json:"yes" yaml:"yes"`
+	Abstain    sdk.Int          `json:"abstain" yaml:"abstain"`
+	No         sdk.Int          `json:"no" yaml:"no"`
+	Validators []sdk.ValAddress `json:"validators,omitempty" yaml:"validators,omitempty"` // validators whose votes were counted
 }
 
 // NewTallyResult creates a new TallyResult instance
@@ -38,12 +104,17 @@ func NewTallyResult(yes, abstain, no sdk.Int) TallyResult {
 	}
 }
 
-// NewTallyResultFromMap creates a new TallyResult instance from a Option -> Dec map
+// NewTallyResultFromMap creates a new TallyResult instance from a Option -> Dec map.
+// NoWithVeto votes are counted as No.
 func NewTallyResultFromMap(results map[VoteOption]sdk.Dec) TallyResult {
+	no := results[OptionNo]
+	if noWithVeto, ok := results[OptionNoWithVeto]; ok {
+		no = no.Add(noWithVeto)
+	}
 	return NewTallyResult(
 		results[OptionYes].TruncateInt(),
 		results[OptionAbstain].TruncateInt(),
-		results[OptionNo].TruncateInt(),
+		no.TruncateInt(),
 	)
 }
 
@@ -52,7 +123,7 @@ func EmptyTallyResult() TallyResult {
 	return NewTallyResult(sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
 }
 
-// Equals returns if two proposals are equal.
+// Equals returns if two proposals are equal. The counted validators are not compared.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.Yes.Equal(comp.Yes) &&
 		tr.Abstain.Equal(comp.Abstain) &&
@@ -61,8 +132,15 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 
 // String implements stringer interface
 func (tr TallyResult) String() string {
-	return fmt.Sprintf(`Tally Result:
+	out := fmt.Sprintf(`Tally Result:
   Yes:        %s
   Abstain:    %s
   No:         %s`, tr.Yes, tr.Abstain, tr.No)
+	if len(tr.Validators) > 0 {
+		out += "\n  Validators:"
+		for _, val := range tr.Validators {
+			out += fmt.Sprintf("\n    %s", val)
+		}
+	}
+	return out
 }
@@ -57,6 +57,8 @@ const (
 	OptionYes     VoteOption = 0x01
 	OptionAbstain VoteOption = 0x02
 	OptionNo      VoteOption = 0x03
+	// OptionNoWithVeto counts as No and burns the proposal deposits when it reaches the veto threshold
+	OptionNoWithVeto VoteOption = 0x04
 )
 
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
@@ -72,6 +74,9 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	case "No":
 		return OptionNo, nil
 
+	case "NoWithVeto":
+		return OptionNoWithVeto, nil
+
 	default:
 		return VoteOption(0xff), fmt.Errorf("'%s' is not a valid vote option", str)
 	}
@@ -81,7 +86,8 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 func ValidVoteOption(option VoteOption) bool {
 	if option == OptionYes ||
 		option == OptionAbstain ||
-		option == OptionNo {
+		option == OptionNo ||
+		option == OptionNoWithVeto {
 		return true
 	}
 	return false
@@ -129,6 +135,8 @@ func (vo VoteOption) String() string {
 		return "Abstain"
 	case OptionNo:
 		return "No"
+	case OptionNoWithVeto:
+		return "NoWithVeto"
 	default:
 		return ""
 	}
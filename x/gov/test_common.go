@@ -136,6 +136,7 @@ func getTestInput(t *testing.T, numGenAccs int, genState types.GenesisState, gen
 		types.ModuleName:            {supply.Burner},
 		validator.NotBondedPoolName: {supply.Burner, supply.Staking},
 		validator.BondedPoolName:    {supply.Burner, supply.Staking},
+		validator.TreasuryPoolName:  nil,
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bk, maccPerms)
 
@@ -147,16 +148,20 @@ func getTestInput(t *testing.T, numGenAccs int, genState types.GenesisState, gen
 		Volume: coinConfig.InitialVolumeBaseCoin,
 	})
 
-	nftKeeper := nft.NewKeeper(cdc, keyNFT, supplyKeeper, validator.DefaultBondDenom)
+	nftKeeper := nft.NewKeeper(cdc, keyNFT, supplyKeeper, bk, validator.DefaultBondDenom)
 
-	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bk)
+	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bk, nil)
 	sk := validator.NewKeeper(cdc, keyValidator, pk.Subspace(validator.DefaultParamSpace), coinKeeper, accountKeeper, supplyKeeper, multisigKeeper, nftKeeper, auth.FeeCollectorName)
 	sk.SetParams(ctx, validator.DefaultParams())
 
 	keeper := keep.NewKeeper(
-		cdc, keyCoin, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, rtr,
+		cdc, keyCoin, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), pk, supplyKeeper, sk, rtr,
 	)
 	keeper.SetTallyParams(ctx, types.DefaultParams().TallyParams)
+	// the genesis exported before the params were initialized has no deposit params
+	if genState.IsEmpty() || !genState.DepositParams.IsEmpty() {
+		keeper.SetDepositParams(ctx, types.DefaultParams().DepositParams)
+	}
 	keeper.SetProposalID(ctx, 1)
 
 	var (
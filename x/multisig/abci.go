@@ -3,6 +3,8 @@ package multisig
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -11,7 +13,25 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	// 	TODO: fill out if your application requires beginblock, if not you can delete this function
 }
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker called every block, marks pending transactions with passed expiration height as expired.
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	// 	TODO: fill out if your application requires endblock, if not you can delete this function
+	var expired []Transaction
+	k.IterateExpiredTransactions(ctx, ctx.BlockHeight()+1, func(transaction Transaction) bool {
+		expired = append(expired, transaction)
+		return false
+	})
+
+	for _, transaction := range expired {
+		if transaction.Status != types.TxStatusPending {
+			k.DeleteTransactionExpiration(ctx, transaction)
+			continue
+		}
+		k.FinishTransaction(ctx, transaction, types.TxStatusExpired)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpireTransaction,
+			sdk.NewAttribute(types.AttributeKeyWallet, transaction.Wallet.String()),
+			sdk.NewAttribute(types.AttributeKeyTransaction, transaction.ID),
+		))
+	}
 }
@@ -19,6 +19,19 @@ const (
 	CreateTransactionConst = types.CreateTransactionConst
 	CreateWalletConst      = types.CreateWalletConst
 	SignTransactionConst   = types.SignTransactionConst
+
+	CreateUniversalTransactionConst = types.CreateUniversalTransactionConst
+	AddOwnerConst                   = types.AddOwnerConst
+	RemoveOwnerConst                = types.RemoveOwnerConst
+	ChangeWeightConst               = types.ChangeWeightConst
+	ChangeThresholdConst            = types.ChangeThresholdConst
+	RevokeSignatureConst            = types.RevokeSignatureConst
+	CancelTransactionConst          = types.CancelTransactionConst
+
+	TxStatusPending   = types.TxStatusPending
+	TxStatusExecuted  = types.TxStatusExecuted
+	TxStatusExpired   = types.TxStatusExpired
+	TxStatusCancelled = types.TxStatusCancelled
 )
 
 var (
@@ -35,6 +48,17 @@ var (
 	NewWallet               = types.NewWallet
 	NewTransaction          = types.NewTransaction
 
+	NewMsgCreateUniversalTransaction = types.NewMsgCreateUniversalTransaction
+	NewUniversalTransaction          = types.NewUniversalTransaction
+	NewMsgAddOwner                   = types.NewMsgAddOwner
+	NewMsgRemoveOwner                = types.NewMsgRemoveOwner
+	NewMsgChangeWeight               = types.NewMsgChangeWeight
+	NewMsgChangeThreshold            = types.NewMsgChangeThreshold
+	NewMsgRevokeSignature            = types.NewMsgRevokeSignature
+	NewMsgCancelTransaction          = types.NewMsgCancelTransaction
+	NewQueryWalletsParams            = types.NewQueryWalletsParams
+	NewQueryTransactionsParams       = types.NewQueryTransactionsParams
+
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 	// TODO: Fill out variable aliases
@@ -52,4 +76,15 @@ type (
 	QueryTransactions    = types.QueryTransactions
 	Wallet               = types.Wallet
 	Transaction          = types.Transaction
+
+	MsgCreateUniversalTransaction = types.MsgCreateUniversalTransaction
+	MsgAddOwner                   = types.MsgAddOwner
+	MsgRemoveOwner                = types.MsgRemoveOwner
+	MsgChangeWeight               = types.MsgChangeWeight
+	MsgChangeThreshold            = types.MsgChangeThreshold
+	WalletMsg                     = types.WalletMsg
+	MsgRevokeSignature            = types.MsgRevokeSignature
+	MsgCancelTransaction          = types.MsgCancelTransaction
+	QueryWalletsParams            = types.QueryWalletsParams
+	QueryTransactionsParams       = types.QueryTransactionsParams
 )
@@ -4,15 +4,20 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
 )
 
+// FlagStatus is the flag filtering multisig transactions by status.
+const FlagStatus = "status"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group multisig queries under a subcommand
@@ -30,6 +35,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			getWalletCommand(queryRoute, cdc),
 			listTransactionsCommand(queryRoute, cdc),
 			getTransactionCommand(queryRoute, cdc),
+			awaitingTransactionsCommand(queryRoute, cdc),
 		)...,
 	)
 
@@ -38,16 +44,25 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // listWalletsCommand queries a list of wallets containing owner with specified address.
 func listWalletsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list-wallets [owner]",
 		Short: "List all multi-signature wallets by owner address",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			owner := args[0]
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listWallets/%s", queryRoute, owner), nil)
+			params := types.NewQueryWalletsParams(owner, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listWallets", queryRoute), bz)
 			if err != nil {
 				fmt.Printf("could not list multi-signature wallets\n")
 				return nil
@@ -58,6 +73,11 @@ func listWalletsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of wallets to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of wallets to query for")
+
+	return cmd
 }
 
 // getWalletCommand queries a wallet with specified address.
@@ -86,16 +106,30 @@ func getWalletCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // listTransactionsCommand queries a list of transactions for the wallet with specified address.
 func listTransactionsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list-transactions [wallet]",
 		Short: "List all multi-signature transactions by wallet address",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			wallet := args[0]
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			status := viper.GetString(FlagStatus)
+			if len(status) > 0 && !types.IsValidTxStatus(status) {
+				return fmt.Errorf("unknown transaction status %s", status)
+			}
+
+			params := types.NewQueryTransactionsParams(wallet, status, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listTransactions/%s", queryRoute, wallet), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listTransactions", queryRoute), bz)
 			if err != nil {
 				fmt.Printf("could not list multi-signature transactions\n")
 				return nil
@@ -106,6 +140,12 @@ func listTransactionsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().String(FlagStatus, "", "filter transactions by status (pending|executed|expired|cancelled)")
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of transactions to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of transactions to query for")
+
+	return cmd
 }
 
 // getTransactionCommand queries a transaction with specified ID.
@@ -131,3 +171,41 @@ func getTransactionCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// awaitingTransactionsCommand queries a list of pending transactions waiting for signature of the owner.
+func awaitingTransactionsCommand(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "awaiting-transactions [owner]",
+		Short: "List pending multi-signature transactions awaiting signature of the owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryWalletsParams(owner, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/awaitingTransactions", queryRoute), bz)
+			if err != nil {
+				fmt.Printf("could not list multi-signature transactions\n")
+				return nil
+			}
+
+			var out types.QueryTransactions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of transactions to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of transactions to query for")
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -18,6 +19,9 @@ import (
 	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
 )
 
+// FlagExpiresAt is the flag specifying block height after which multisig transaction cannot be signed anymore.
+const FlagExpiresAt = "expires-at"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	multisigTxCmd := &cobra.Command{
@@ -32,6 +36,13 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdCreateWallet(cdc),
 		getCmdCreateTransaction(cdc),
 		getCmdSignTransaction(cdc),
+		withExpiresAtFlag(getCmdCreateUniversalTransaction(cdc)),
+		withExpiresAtFlag(getCmdAddOwner(cdc)),
+		withExpiresAtFlag(getCmdRemoveOwner(cdc)),
+		withExpiresAtFlag(getCmdChangeWeight(cdc)),
+		withExpiresAtFlag(getCmdChangeThreshold(cdc)),
+		getCmdRevokeSignature(cdc),
+		getCmdCancelTransaction(cdc),
 	)...)
 
 	return multisigTxCmd
@@ -91,7 +102,7 @@ func getCmdCreateWallet(cdc *codec.Codec) *cobra.Command {
 
 // getCmdCreateTransaction is the CLI command for sending a CreateTransaction transaction.
 func getCmdCreateTransaction(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create-transaction [wallet] [receiver] [coins]",
 		Short: "create a new multi-signature transaction",
 		Args:  cobra.ExactArgs(3),
@@ -130,7 +141,9 @@ func getCmdCreateTransaction(cdc *codec.Codec) *cobra.Command {
 			// 	}
 			// }
 
-			msg := types.NewMsgCreateTransaction(cliCtx.GetFromAddress(), wallet, receiver, coins)
+			expiresAt := viper.GetInt64(FlagExpiresAt)
+
+			msg := types.NewMsgCreateTransaction(cliCtx.GetFromAddress(), wallet, receiver, coins, expiresAt)
 			if err != nil {
 				return err
 			}
@@ -144,6 +157,14 @@ func getCmdCreateTransaction(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	return withExpiresAtFlag(cmd)
+}
+
+// withExpiresAtFlag adds the flag of the expiration height to the command creating a multi-signature transaction.
+func withExpiresAtFlag(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Int64(FlagExpiresAt, 0, "block height after which the transaction cannot be signed (0 means it never expires)")
+	return cmd
 }
 
 // getCmdSignTransaction is the CLI command for saving a transaction signature.
@@ -172,3 +193,201 @@ func getCmdSignTransaction(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// getCmdCreateUniversalTransaction is the CLI command for sending a CreateUniversalTransaction transaction.
+func getCmdCreateUniversalTransaction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-universal-transaction [wallet] [tx-file]",
+		Short: "create a new multi-signature transaction executing the message on behalf of the wallet",
+		Long: `Create a new multi-signature transaction executing the message on behalf of the wallet.
+The message is read from the transaction file generated with --generate-only flag, for example:
+
+$ deccli tx validator delegate [validator-addr] 1000del --from [wallet] --generate-only > tx.json
+$ deccli tx multisig create-universal-transaction [wallet] tx.json --from [owner]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(cliCtx.Input).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			if len(stdTx.Msgs) != 1 {
+				return fmt.Errorf("transaction file must contain exactly one message, got %d", len(stdTx.Msgs))
+			}
+
+			msg := types.NewMsgCreateUniversalTransaction(cliCtx.GetFromAddress(), wallet, stdTx.Msgs[0], viper.GetInt64(FlagExpiresAt))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// getCmdAddOwner is the CLI command for creating a multi-signature transaction adding new owner to the wallet.
+func getCmdAddOwner(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-owner [wallet] [owner] [weight]",
+		Short: "create a new multi-signature transaction adding new owner to the wallet",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			weight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return createWalletTransaction(cdc, wallet, types.NewMsgAddOwner(wallet, owner, uint(weight)))
+		},
+	}
+}
+
+// getCmdRemoveOwner is the CLI command for creating a multi-signature transaction removing owner from the wallet.
+func getCmdRemoveOwner(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-owner [wallet] [owner]",
+		Short: "create a new multi-signature transaction removing owner from the wallet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			return createWalletTransaction(cdc, wallet, types.NewMsgRemoveOwner(wallet, owner))
+		},
+	}
+}
+
+// getCmdChangeWeight is the CLI command for creating a multi-signature transaction changing weight of the wallet owner.
+func getCmdChangeWeight(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-weight [wallet] [owner] [weight]",
+		Short: "create a new multi-signature transaction changing weight of the wallet owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			weight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return createWalletTransaction(cdc, wallet, types.NewMsgChangeWeight(wallet, owner, uint(weight)))
+		},
+	}
+}
+
+// getCmdChangeThreshold is the CLI command for creating a multi-signature transaction changing threshold of the wallet.
+func getCmdChangeThreshold(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-threshold [wallet] [threshold]",
+		Short: "create a new multi-signature transaction changing threshold of the wallet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wallet, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return createWalletTransaction(cdc, wallet, types.NewMsgChangeThreshold(wallet, uint(threshold)))
+		},
+	}
+}
+
+// createWalletTransaction wraps the wallet management message into a new multi-signature transaction and broadcasts it.
+func createWalletTransaction(cdc *codec.Codec, wallet sdk.AccAddress, message types.WalletMsg) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	txBldr := auth.NewTxBuilderFromCLI(cliCtx.Input).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	msg := types.NewMsgCreateUniversalTransaction(cliCtx.GetFromAddress(), wallet, message, viper.GetInt64(FlagExpiresAt))
+	err := msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+// getCmdRevokeSignature is the CLI command for revoking a signature of pending transaction.
+func getCmdRevokeSignature(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-signature [tx-id]",
+		Short: "Revoke a signature previously saved for a pending transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(cliCtx.Input).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRevokeSignature(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// getCmdCancelTransaction is the CLI command for cancelling a pending transaction.
+func getCmdCancelTransaction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-transaction [tx-id]",
+		Short: "Cancel a pending transaction or vote for its cancellation",
+		Long: `Cancel a pending transaction or vote for its cancellation.
+The transaction is cancelled immediately when its creator sends the command.
+Otherwise the command is counted as a vote of the owner, and the transaction
+is cancelled once summary weight of the votes reaches the wallet threshold.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(cliCtx.Input).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCancelTransaction(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
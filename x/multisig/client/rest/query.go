@@ -8,6 +8,7 @@ import (
 
 	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
@@ -17,6 +18,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/multisig/parameters",
 		queryParamsHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/multisig/owners/{owner}/wallets",
+		queryWalletsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/multisig/owners/{owner}/awaiting",
+		queryAwaitingTransactionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/multisig/wallets/{wallet}",
+		queryWalletHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/multisig/wallets/{wallet}/transactions",
+		queryTransactionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/multisig/transactions/{txID}",
+		queryTransactionHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -38,3 +59,134 @@ func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query wallets owned by the address, supports "page" and "limit" URL params.
+func queryWalletsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryOwnerHandlerFn(cliCtx, "listWallets")
+}
+
+// HTTP request handler to query transactions awaiting signature of the owner, supports "page" and "limit" URL params.
+func queryAwaitingTransactionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryOwnerHandlerFn(cliCtx, "awaitingTransactions")
+}
+
+func queryOwnerHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["owner"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryWalletsParams(owner, page, limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query transactions of the wallet, supports "status", "page" and "limit" URL params.
+func queryTransactionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wallet, err := sdk.AccAddressFromBech32(mux.Vars(r)["wallet"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		status := r.FormValue("status")
+		if len(status) > 0 && !types.IsValidTxStatus(status) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown transaction status %s", status))
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryTransactionsParams(wallet, status, page, limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listTransactions", types.QuerierRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the wallet by address.
+func queryWalletHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/getWallet/%s", types.QuerierRoute, mux.Vars(r)["wallet"])
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the transaction by ID.
+func queryTransactionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/getTransaction/%s", types.QuerierRoute, mux.Vars(r)["txID"])
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -8,6 +8,10 @@ import (
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorUpdate {
+	// the chains started before the indexes were introduced build them by the upgrade
+	if data.Indexed {
+		k.MigrateIndexes(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -17,5 +21,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 	wallets := k.GetAllWallets(ctx)
 	txs := k.GetAllTransactions(ctx)
-	return NewGenesisState(wallets, txs)
+	data = NewGenesisState(wallets, txs)
+	data.Indexed = k.IsIndexed(ctx)
+	return data
 }
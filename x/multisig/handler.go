@@ -29,6 +29,20 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgCreateTransaction(ctx, k, msg)
 		case MsgSignTransaction:
 			return handleMsgSignTransaction(ctx, k, msg, true)
+		case MsgCreateUniversalTransaction:
+			return handleMsgCreateUniversalTransaction(ctx, k, msg)
+		case MsgAddOwner:
+			return handleMsgAddOwner(ctx, k, msg)
+		case MsgRemoveOwner:
+			return handleMsgRemoveOwner(ctx, k, msg)
+		case MsgChangeWeight:
+			return handleMsgChangeWeight(ctx, k, msg)
+		case MsgChangeThreshold:
+			return handleMsgChangeThreshold(ctx, k, msg)
+		case MsgRevokeSignature:
+			return handleMsgRevokeSignature(ctx, k, msg)
+		case MsgCancelTransaction:
+			return handleMsgCancelTransaction(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -98,6 +112,11 @@ func handleMsgCreateTransaction(ctx sdk.Context, keeper Keeper, msg MsgCreateTra
 		}
 	}
 
+	// Ensure expiration height is not passed yet
+	if msg.ExpiresAt > 0 && msg.ExpiresAt < ctx.BlockHeight() {
+		return nil, types.ErrInvalidExpiration(strconv.FormatInt(msg.ExpiresAt, 10))
+	}
+
 	// Create new multisig transaction
 	transaction, err := NewTransaction(
 		msg.Wallet,
@@ -111,9 +130,14 @@ func handleMsgCreateTransaction(ctx sdk.Context, keeper Keeper, msg MsgCreateTra
 		msgError := "Unable to create multi-signature transaction"
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
 	}
+	transaction.Creator = msg.Sender
+	transaction.ExpiresAt = msg.ExpiresAt
 
 	// Save created multisig transaction to the KVStore
 	keeper.SetTransaction(ctx, *transaction)
+	if transaction.ExpiresAt > 0 {
+		keeper.SetTransactionExpiration(ctx, *transaction)
+	}
 
 	// Sign created multisig transaction by the creator
 	signEvents, err := handleMsgSignTransaction(ctx, keeper, MsgSignTransaction{
@@ -134,12 +158,79 @@ func handleMsgCreateTransaction(ctx sdk.Context, keeper Keeper, msg MsgCreateTra
 		sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver.String()),
 		sdk.NewAttribute(types.AttributeKeyCoins, msg.Coins.String()),
 		sdk.NewAttribute(types.AttributeKeyTransaction, transaction.ID),
+		sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatInt(msg.ExpiresAt, 10)),
 	))
 	ctx.EventManager().EmitEvents(signEvents.Events)
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCreateUniversalTransaction(ctx sdk.Context, keeper Keeper, msg MsgCreateUniversalTransaction) (*sdk.Result, error) {
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, msg.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", msg.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the message can be executed on behalf of the wallet
+	err := types.ValidateWalletMessage(wallet.Address, msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure expiration height is not passed yet
+	if msg.ExpiresAt > 0 && msg.ExpiresAt < ctx.BlockHeight() {
+		return nil, types.ErrInvalidExpiration(strconv.FormatInt(msg.ExpiresAt, 10))
+	}
+
+	// Create new multisig transaction
+	transaction, err := types.NewUniversalTransaction(
+		msg.Wallet,
+		msg.Message,
+		make([]sdk.AccAddress, len(wallet.Owners)),
+		ctx.BlockHeight(),
+		ctx.TxBytes(),
+	)
+	if err != nil {
+		msgError := "Unable to create multi-signature transaction"
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+	transaction.Creator = msg.Sender
+	transaction.ExpiresAt = msg.ExpiresAt
+
+	// Save created multisig transaction to the KVStore
+	keeper.SetTransaction(ctx, *transaction)
+	if transaction.ExpiresAt > 0 {
+		keeper.SetTransactionExpiration(ctx, *transaction)
+	}
+
+	// Sign created multisig transaction by the creator
+	signResult, err := handleMsgSignTransaction(ctx, keeper, MsgSignTransaction{
+		Sender: msg.Sender,
+		TxID:   transaction.ID,
+	}, false)
+	if err != nil {
+		msgError := fmt.Sprintf("Unable to sign created multi-signature transaction with ID %s by it's creator: %s", transaction.ID, err.Error())
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyWallet, msg.Wallet.String()),
+		sdk.NewAttribute(types.AttributeKeyMessageRoute, msg.Message.Route()),
+		sdk.NewAttribute(types.AttributeKeyMessageType, msg.Message.Type()),
+		sdk.NewAttribute(types.AttributeKeyTransaction, transaction.ID),
+		sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatInt(msg.ExpiresAt, 10)),
+	))
+	ctx.EventManager().EmitEvents(signResult.Events)
+
+	return &sdk.Result{Data: signResult.Data, Log: signResult.Log, Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgSignTransaction(ctx sdk.Context, keeper Keeper, msg MsgSignTransaction, emitEvents bool) (*sdk.Result, error) {
 	// Retrieve multisig transaction from the KVStore
 	transaction := keeper.GetTransaction(ctx, msg.TxID)
@@ -148,6 +239,14 @@ func handleMsgSignTransaction(ctx sdk.Context, keeper Keeper, msg MsgSignTransac
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
 	}
 
+	// Ensure the transaction is still waiting for signatures
+	if transaction.Status != types.TxStatusPending {
+		return nil, types.ErrTransactionNotPending(transaction.ID, transaction.Status)
+	}
+	if transaction.IsExpired(ctx.BlockHeight()) {
+		return nil, types.ErrTransactionExpired(transaction.ID, strconv.FormatInt(transaction.ExpiresAt, 10))
+	}
+
 	// Retrieve multisig wallet from the KVStore
 	wallet := keeper.GetWallet(ctx, transaction.Wallet.String())
 	if wallet.Address.Empty() {
@@ -163,8 +262,10 @@ func handleMsgSignTransaction(ctx sdk.Context, keeper Keeper, msg MsgSignTransac
 		}
 	}
 
-	// Ensure current weight of signatures is not enough
-	if confirmations >= wallet.Threshold {
+	// Ensure current weight of signatures is not enough for the transaction stored before statuses were introduced.
+	// The weight may already be enough for the transaction stored as pending once the threshold of the wallet
+	// is lowered, then the transaction is performed with the next signature.
+	if confirmations >= wallet.Threshold && !keeper.HasStoredStatus(ctx, transaction.ID) {
 		msgError := fmt.Sprintf("Multi-signature transaction already has enough signatures (%d >= %d)", confirmations, wallet.Threshold)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
 	}
@@ -193,13 +294,22 @@ func handleMsgSignTransaction(ctx sdk.Context, keeper Keeper, msg MsgSignTransac
 
 	// Check if new weight of signatures is enough to perform multisig transaction
 	confirmed := confirmations >= wallet.Threshold
+	result := &sdk.Result{}
 	if confirmed {
 		// Perform transaction
-		err := keeper.BankKeeper.SendCoins(ctx, wallet.Address, transaction.Receiver, transaction.Coins)
+		var err error
+		if transaction.Message != nil {
+			result, err = keeper.ExecuteMessage(ctx, transaction.Message)
+		} else {
+			err = keeper.BankKeeper.SendCoins(ctx, wallet.Address, transaction.Receiver, transaction.Coins)
+		}
 		if err != nil {
 			msgError := fmt.Sprintf("Unable to perform multi-signature transaction %s: %s", transaction.ID, err.Error())
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
 		}
+
+		// Mark multisig transaction as executed
+		transaction = keeper.FinishTransaction(ctx, transaction, types.TxStatusExecuted)
 	}
 
 	// Emit transaction events
@@ -215,9 +325,263 @@ func handleMsgSignTransaction(ctx sdk.Context, keeper Keeper, msg MsgSignTransac
 			sdk.NewAttribute(types.AttributeKeyConfirmed, strconv.FormatBool(confirmed)),
 		),
 	}
+	if confirmed && transaction.Message != nil {
+		// Report result of the executed message
+		events = append(events, sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTransaction, msg.TxID),
+			sdk.NewAttribute(types.AttributeKeyMessageRoute, transaction.Message.Route()),
+			sdk.NewAttribute(types.AttributeKeyMessageType, transaction.Message.Type()),
+			sdk.NewAttribute(types.AttributeKeyResultLog, result.Log),
+		))
+		events = append(events, result.Events...)
+	}
 	if !emitEvents {
-		return &sdk.Result{Events: events}, nil
+		return &sdk.Result{Data: result.Data, Log: result.Log, Events: events}, nil
 	}
 	ctx.EventManager().EmitEvents(events)
+	return &sdk.Result{Data: result.Data, Log: result.Log, Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAddOwner(ctx sdk.Context, keeper Keeper, msg MsgAddOwner) (*sdk.Result, error) {
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, msg.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", msg.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the address is not an owner yet
+	if wallet.OwnerIndex(msg.Owner) >= 0 {
+		return nil, types.ErrOwnerAlreadyExists(msg.Owner.String())
+	}
+
+	// Append new owner and ensure the wallet is still valid
+	wallet.Owners = append(wallet.Owners, msg.Owner)
+	wallet.Weights = append(wallet.Weights, msg.Weight)
+	err := wallet.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save updated multisig wallet to the KVStore
+	keeper.UpdateWallet(ctx, wallet)
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyWallet, msg.Wallet.String()),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatUint(uint64(msg.Weight), 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveOwner(ctx sdk.Context, keeper Keeper, msg MsgRemoveOwner) (*sdk.Result, error) {
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, msg.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", msg.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the address is an owner
+	index := wallet.OwnerIndex(msg.Owner)
+	if index < 0 {
+		return nil, types.ErrOwnerNotFound(msg.Owner.String())
+	}
+
+	// Remove the owner and ensure the threshold is still reachable
+	owners := make([]sdk.AccAddress, 0, len(wallet.Owners)-1)
+	weights := make([]uint, 0, len(wallet.Weights)-1)
+	owners = append(append(owners, wallet.Owners[:index]...), wallet.Owners[index+1:]...)
+	weights = append(append(weights, wallet.Weights[:index]...), wallet.Weights[index+1:]...)
+	wallet.Owners, wallet.Weights = owners, weights
+	err := wallet.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save updated multisig wallet to the KVStore
+	keeper.UpdateWallet(ctx, wallet)
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyWallet, msg.Wallet.String()),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgChangeWeight(ctx sdk.Context, keeper Keeper, msg MsgChangeWeight) (*sdk.Result, error) {
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, msg.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", msg.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the address is an owner
+	index := wallet.OwnerIndex(msg.Owner)
+	if index < 0 {
+		return nil, types.ErrOwnerNotFound(msg.Owner.String())
+	}
+
+	// Change the weight and ensure the threshold is still reachable
+	wallet.Weights[index] = msg.Weight
+	err := wallet.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save updated multisig wallet to the KVStore
+	keeper.UpdateWallet(ctx, wallet)
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyWallet, msg.Wallet.String()),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatUint(uint64(msg.Weight), 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgChangeThreshold(ctx sdk.Context, keeper Keeper, msg MsgChangeThreshold) (*sdk.Result, error) {
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, msg.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", msg.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Change the threshold and ensure it is reachable
+	wallet.Threshold = msg.Threshold
+	err := wallet.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save updated multisig wallet to the KVStore
+	keeper.UpdateWallet(ctx, wallet)
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyWallet, msg.Wallet.String()),
+		sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeSignature(ctx sdk.Context, keeper Keeper, msg MsgRevokeSignature) (*sdk.Result, error) {
+	// Retrieve multisig transaction from the KVStore
+	transaction := keeper.GetTransaction(ctx, msg.TxID)
+	if transaction.Wallet.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature transaction with ID %s", msg.TxID)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the transaction is still waiting for signatures
+	if transaction.Status != types.TxStatusPending {
+		return nil, types.ErrTransactionNotPending(transaction.ID, transaction.Status)
+	}
+
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, transaction.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", transaction.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Remove the signature from the multisig transaction
+	index := wallet.OwnerIndex(msg.Sender)
+	if index < 0 {
+		return nil, types.ErrOwnerNotFound(msg.Sender.String())
+	}
+	if transaction.Signers[index].Empty() {
+		return nil, types.ErrSignatureNotFound(transaction.ID, msg.Sender.String())
+	}
+	transaction.Signers[index] = nil
+
+	// Save updated multisig transaction to the KVStore
+	keeper.SetTransaction(ctx, transaction)
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyWallet, wallet.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyTransaction, msg.TxID),
+		sdk.NewAttribute(types.AttributeKeyConfirmations, strconv.FormatUint(uint64(wallet.Confirmations(transaction.Signers)), 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelTransaction(ctx sdk.Context, keeper Keeper, msg MsgCancelTransaction) (*sdk.Result, error) {
+	// Retrieve multisig transaction from the KVStore
+	transaction := keeper.GetTransaction(ctx, msg.TxID)
+	if transaction.Wallet.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature transaction with ID %s", msg.TxID)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Ensure the transaction is still waiting for signatures
+	if transaction.Status != types.TxStatusPending {
+		return nil, types.ErrTransactionNotPending(transaction.ID, transaction.Status)
+	}
+
+	// Retrieve multisig wallet from the KVStore
+	wallet := keeper.GetWallet(ctx, transaction.Wallet.String())
+	if wallet.Address.Empty() {
+		msgError := fmt.Sprintf("No registered multi-signature wallet with address %s", transaction.Wallet)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
+	}
+
+	// Creator cancels the transaction immediately while other owners vote for cancellation
+	cancelled := msg.Sender.Equals(transaction.Creator)
+	if !cancelled {
+		if wallet.OwnerIndex(msg.Sender) < 0 {
+			return nil, types.ErrOwnerNotFound(msg.Sender.String())
+		}
+		for _, voter := range transaction.Cancellations {
+			if voter.Equals(msg.Sender) {
+				return nil, types.ErrAlreadyVoted(transaction.ID, msg.Sender.String())
+			}
+		}
+		transaction.Cancellations = append(transaction.Cancellations, msg.Sender)
+		cancelled = wallet.Confirmations(transaction.Cancellations) >= wallet.Threshold
+	}
+
+	// Save updated multisig transaction to the KVStore
+	if cancelled {
+		transaction = keeper.FinishTransaction(ctx, transaction, types.TxStatusCancelled)
+	} else {
+		keeper.SetTransaction(ctx, transaction)
+	}
+
+	// Emit transaction events
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyWallet, wallet.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyTransaction, msg.TxID),
+		sdk.NewAttribute(types.AttributeKeyCancellations, strconv.FormatUint(uint64(wallet.Confirmations(transaction.Cancellations)), 10)),
+		sdk.NewAttribute(types.AttributeKeyStatus, transaction.Status),
+	))
+
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
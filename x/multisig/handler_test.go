@@ -5,6 +5,7 @@ import (
 	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -63,3 +64,315 @@ func TestMsgCreateWalletWithExistAddress(t *testing.T) {
 	_, err = handleMsgCreateWallet(ctx, keeper, msgCreateWallet)
 	require.Errorf(t, err, fmt.Sprintf("Account with address %s already exists", wallet.Address.String()))
 }
+
+func TestMsgCreateUniversalTransaction(t *testing.T) {
+	ctx, keeper, _, accountKeeper, bankKeeper := mul.CreateTestInput(t, false)
+
+	msgCreateWallet := types.NewMsgCreateWallet(mul.Addrs[0], []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1]}, []uint{1, 1}, 2)
+	_, err := handleMsgCreateWallet(ctx, keeper, msgCreateWallet)
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet([]sdk.AccAddress{mul.Addrs[0], mul.Addrs[1]}, []uint{1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000)))
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, coins)
+	require.NoError(t, err)
+
+	// message must be signed by the wallet
+	send := bank.NewMsgSend(mul.Addrs[0], mul.Addrs[2], coins)
+	_, err = handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(mul.Addrs[0], wallet.Address, send, 0))
+	require.Error(t, err)
+
+	send = bank.NewMsgSend(wallet.Address, mul.Addrs[2], coins)
+	res, err := handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(mul.Addrs[0], wallet.Address, send, 0))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	transactions := keeper.GetAllTransactions(ctx)
+	require.Len(t, transactions, 1)
+	require.Equal(t, send, transactions[0].Message)
+	require.True(t, bankKeeper.GetCoins(ctx, mul.Addrs[2]).IsZero())
+
+	// the message is executed once threshold is reached
+	res, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], transactions[0].ID), true)
+	require.NoError(t, err)
+	require.Equal(t, coins, bankKeeper.GetCoins(ctx, mul.Addrs[2]))
+	require.True(t, bankKeeper.GetCoins(ctx, wallet.Address).IsZero())
+
+	executed := false
+	for _, event := range res.Events {
+		if event.Type == bank.EventTypeTransfer {
+			executed = true
+		}
+	}
+	require.True(t, executed)
+}
+
+func TestWalletOwnersManagement(t *testing.T) {
+	ctx, keeper, _, _, _ := mul.CreateTestInput(t, false)
+	keeper.Router().AddRoute(RouterKey, NewHandler(keeper))
+
+	msgCreateWallet := types.NewMsgCreateWallet(mul.Addrs[0], []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1]}, []uint{1, 1}, 2)
+	_, err := handleMsgCreateWallet(ctx, keeper, msgCreateWallet)
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet([]sdk.AccAddress{mul.Addrs[0], mul.Addrs[1]}, []uint{1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+
+	// execute wallet management message confirmed by the owners
+	execute := func(message types.WalletMsg, owners ...sdk.AccAddress) error {
+		w := keeper.GetWallet(ctx, wallet.Address.String())
+		_, err := handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(owners[0], wallet.Address, message, 0))
+		if err != nil {
+			return err
+		}
+		tx, err := types.NewUniversalTransaction(wallet.Address, message, make([]sdk.AccAddress, len(w.Owners)), ctx.BlockHeight(), ctx.TxBytes())
+		require.NoError(t, err)
+		for _, owner := range owners[1:] {
+			_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(owner, tx.ID), true)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	require.NoError(t, execute(types.NewMsgAddOwner(wallet.Address, mul.Addrs[2], 2), mul.Addrs[0], mul.Addrs[1]))
+	w := keeper.GetWallet(ctx, wallet.Address.String())
+	require.Equal(t, []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}, w.Owners)
+	require.Equal(t, []uint{1, 1, 2}, w.Weights)
+
+	require.NoError(t, execute(types.NewMsgChangeThreshold(wallet.Address, 4), mul.Addrs[0], mul.Addrs[2]))
+	require.Equal(t, uint(4), keeper.GetWallet(ctx, wallet.Address.String()).Threshold)
+
+	// threshold must stay reachable
+	require.Error(t, execute(types.NewMsgChangeThreshold(wallet.Address, 5), mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]))
+	require.Error(t, execute(types.NewMsgRemoveOwner(wallet.Address, mul.Addrs[2]), mul.Addrs[2], mul.Addrs[0], mul.Addrs[1]))
+
+	// signatures of pending transactions follow the owners
+	require.NoError(t, execute(types.NewMsgChangeWeight(wallet.Address, mul.Addrs[2], 3), mul.Addrs[2], mul.Addrs[0], mul.Addrs[1]))
+	pending := types.NewMsgChangeThreshold(wallet.Address, 2)
+	require.NoError(t, execute(pending, mul.Addrs[1]))
+	require.NoError(t, execute(types.NewMsgRemoveOwner(wallet.Address, mul.Addrs[0]), mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]))
+	w = keeper.GetWallet(ctx, wallet.Address.String())
+	require.Equal(t, []sdk.AccAddress{mul.Addrs[1], mul.Addrs[2]}, w.Owners)
+	require.Equal(t, []uint{1, 3}, w.Weights)
+	tx, err := types.NewUniversalTransaction(wallet.Address, pending, make([]sdk.AccAddress, 3), ctx.BlockHeight(), ctx.TxBytes())
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{mul.Addrs[1], nil}, keeper.GetTransaction(ctx, tx.ID).Signers)
+
+	// pending transactions confirmed by a lowered threshold are performed with the next signature
+	require.NoError(t, execute(types.NewMsgChangeThreshold(wallet.Address, 1), mul.Addrs[1], mul.Addrs[2]))
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, tx.ID).Status)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[2], tx.ID), true)
+	require.NoError(t, err)
+	require.Equal(t, types.TxStatusExecuted, keeper.GetTransaction(ctx, tx.ID).Status)
+	require.Equal(t, uint(2), keeper.GetWallet(ctx, wallet.Address.String()).Threshold)
+}
+
+func TestTransactionExpirationAndCancellation(t *testing.T) {
+	ctx, keeper, _, accountKeeper, bankKeeper := mul.CreateTestInput(t, false)
+
+	owners := []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}
+	_, err := handleMsgCreateWallet(ctx, keeper, types.NewMsgCreateWallet(mul.Addrs[0], owners, []uint{1, 1, 1}, 2))
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet(owners, []uint{1, 1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000)))
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, coins)
+	require.NoError(t, err)
+
+	createTransaction := func(sender sdk.AccAddress, amount int64, expiresAt int64) types.Transaction {
+		coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(amount)))
+		_, err := handleMsgCreateTransaction(ctx, keeper, types.NewMsgCreateTransaction(sender, wallet.Address, mul.Addrs[3], coins, expiresAt))
+		require.NoError(t, err)
+		tx, err := types.NewTransaction(wallet.Address, mul.Addrs[3], coins, make([]sdk.AccAddress, len(owners)), ctx.BlockHeight(), ctx.TxBytes())
+		require.NoError(t, err)
+		return keeper.GetTransaction(ctx, tx.ID)
+	}
+
+	// expired transaction cannot be signed
+	ctx = ctx.WithBlockHeight(10)
+	tx := createTransaction(mul.Addrs[0], 100, 11)
+	require.Equal(t, types.TxStatusPending, tx.Status)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, tx.ID).Status)
+	ctx = ctx.WithBlockHeight(11)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, types.TxStatusExpired, keeper.GetTransaction(ctx, tx.ID).Status)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], tx.ID), true)
+	require.Error(t, err)
+
+	// universal transactions expire the same way
+	message := types.NewMsgChangeThreshold(wallet.Address, 3)
+	_, err = handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(mul.Addrs[0], wallet.Address, message, 10))
+	require.Error(t, err)
+	_, err = handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(mul.Addrs[0], wallet.Address, message, 12))
+	require.NoError(t, err)
+	universal, err := types.NewUniversalTransaction(wallet.Address, message, make([]sdk.AccAddress, len(owners)), ctx.BlockHeight(), ctx.TxBytes())
+	require.NoError(t, err)
+	require.Equal(t, int64(12), keeper.GetTransaction(ctx, universal.ID).ExpiresAt)
+	EndBlocker(ctx.WithBlockHeight(12), keeper)
+	require.Equal(t, types.TxStatusExpired, keeper.GetTransaction(ctx, universal.ID).Status)
+
+	// revoked signature is not counted
+	tx = createTransaction(mul.Addrs[0], 200, 0)
+	_, err = handleMsgRevokeSignature(ctx, keeper, types.NewMsgRevokeSignature(mul.Addrs[1], tx.ID))
+	require.Error(t, err)
+	_, err = handleMsgRevokeSignature(ctx, keeper, types.NewMsgRevokeSignature(mul.Addrs[0], tx.ID))
+	require.NoError(t, err)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], tx.ID), true)
+	require.NoError(t, err)
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, tx.ID).Status)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[2], tx.ID), true)
+	require.NoError(t, err)
+	require.Equal(t, types.TxStatusExecuted, keeper.GetTransaction(ctx, tx.ID).Status)
+	_, err = handleMsgCancelTransaction(ctx, keeper, types.NewMsgCancelTransaction(mul.Addrs[0], tx.ID))
+	require.Error(t, err)
+
+	// creator cancels the transaction immediately
+	tx = createTransaction(mul.Addrs[1], 300, 0)
+	_, err = handleMsgCancelTransaction(ctx, keeper, types.NewMsgCancelTransaction(mul.Addrs[1], tx.ID))
+	require.NoError(t, err)
+	require.Equal(t, types.TxStatusCancelled, keeper.GetTransaction(ctx, tx.ID).Status)
+
+	// other owners cancel the transaction by threshold-weight vote
+	tx = createTransaction(mul.Addrs[2], 400, 0)
+	_, err = handleMsgCancelTransaction(ctx, keeper, types.NewMsgCancelTransaction(mul.Addrs[0], tx.ID))
+	require.NoError(t, err)
+	_, err = handleMsgCancelTransaction(ctx, keeper, types.NewMsgCancelTransaction(mul.Addrs[0], tx.ID))
+	require.Error(t, err)
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, tx.ID).Status)
+	_, err = handleMsgCancelTransaction(ctx, keeper, types.NewMsgCancelTransaction(mul.Addrs[1], tx.ID))
+	require.NoError(t, err)
+	require.Equal(t, types.TxStatusCancelled, keeper.GetTransaction(ctx, tx.ID).Status)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(200))), bankKeeper.GetCoins(ctx, mul.Addrs[3]))
+}
+
+func TestLegacyTransactionStatus(t *testing.T) {
+	ctx, keeper, _, accountKeeper, bankKeeper := mul.CreateLegacyTestInput(t, false)
+	keeper.Router().AddRoute(RouterKey, NewHandler(keeper))
+
+	owners := []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}
+	_, err := handleMsgCreateWallet(ctx, keeper, types.NewMsgCreateWallet(mul.Addrs[0], owners, []uint{1, 1, 1}, 2))
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet(owners, []uint{1, 1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000))))
+	require.NoError(t, err)
+
+	// transactions stored before statuses were introduced
+	legacyTransaction := func(amount int64, signers ...sdk.AccAddress) types.Transaction {
+		coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(amount)))
+		tx, err := types.NewTransaction(wallet.Address, mul.Addrs[3], coins, signers, 0, []byte{byte(amount)})
+		require.NoError(t, err)
+		tx.Status = ""
+		keeper.SetTransaction(ctx, *tx)
+		return *tx
+	}
+	executed := legacyTransaction(100, mul.Addrs[0], mul.Addrs[1], nil)
+	pending := legacyTransaction(200, mul.Addrs[0], nil, nil)
+	require.Equal(t, types.TxStatusExecuted, keeper.GetTransaction(ctx, executed.ID).Status)
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, pending.ID).Status)
+
+	// the executed transaction stays executed once the threshold is raised
+	message := types.NewMsgChangeThreshold(wallet.Address, 3)
+	_, err = handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(mul.Addrs[0], wallet.Address, message, 0))
+	require.NoError(t, err)
+	universal, err := types.NewUniversalTransaction(wallet.Address, message, make([]sdk.AccAddress, len(owners)), ctx.BlockHeight(), ctx.TxBytes())
+	require.NoError(t, err)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], universal.ID), true)
+	require.NoError(t, err)
+	require.Equal(t, uint(3), keeper.GetWallet(ctx, wallet.Address.String()).Threshold)
+	require.Equal(t, types.TxStatusExecuted, keeper.GetTransaction(ctx, executed.ID).Status)
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[2], executed.ID), true)
+	require.Error(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, mul.Addrs[3]).IsZero())
+
+	// the migration saves the statuses
+	keeper.MigrateIndexes(ctx)
+	require.Equal(t, []string{pending.ID}, keeper.GetPendingTransactionIDs(ctx, wallet.Address))
+	require.Equal(t, types.TxStatusExecuted, keeper.GetTransaction(ctx, executed.ID).Status)
+}
+
+func TestLegacyTransactionWithEnoughSignatures(t *testing.T) {
+	ctx, keeper, _, accountKeeper, bankKeeper := mul.CreateLegacyTestInput(t, false)
+
+	owners := []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}
+	_, err := handleMsgCreateWallet(ctx, keeper, types.NewMsgCreateWallet(mul.Addrs[0], owners, []uint{1, 1, 1}, 2))
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet(owners, []uint{1, 1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000))))
+	require.NoError(t, err)
+
+	// the transaction stored before statuses were introduced is not performed by the signatures
+	// left by the removed owners
+	coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(100)))
+	tx, err := types.NewTransaction(wallet.Address, mul.Addrs[3], coins, []sdk.AccAddress{mul.Addrs[4], mul.Addrs[5], nil}, 0, []byte{1})
+	require.NoError(t, err)
+	tx.Status = ""
+	keeper.SetTransaction(ctx, *tx)
+	require.Equal(t, types.TxStatusPending, keeper.GetTransaction(ctx, tx.ID).Status)
+
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[2], tx.ID), true)
+	require.Error(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, mul.Addrs[3]).IsZero())
+}
+
+func TestLegacyWalletOwnersManagement(t *testing.T) {
+	ctx, keeper, _, accountKeeper, bankKeeper := mul.CreateLegacyTestInput(t, false)
+	keeper.Router().AddRoute(RouterKey, NewHandler(keeper))
+
+	owners := []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}
+	_, err := handleMsgCreateWallet(ctx, keeper, types.NewMsgCreateWallet(mul.Addrs[0], owners, []uint{1, 1, 1}, 2))
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet(owners, []uint{1, 1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000)))
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, coins)
+	require.NoError(t, err)
+
+	_, err = handleMsgCreateTransaction(ctx, keeper, types.NewMsgCreateTransaction(mul.Addrs[0], wallet.Address, mul.Addrs[4], coins, 0))
+	require.NoError(t, err)
+	tx, err := types.NewTransaction(wallet.Address, mul.Addrs[4], coins, make([]sdk.AccAddress, len(owners)), ctx.BlockHeight(), ctx.TxBytes())
+	require.NoError(t, err)
+
+	execute := func(message types.WalletMsg, txBytes byte, owners ...sdk.AccAddress) {
+		ctx := ctx.WithTxBytes([]byte{txBytes})
+		signers := make([]sdk.AccAddress, len(keeper.GetWallet(ctx, wallet.Address.String()).Owners))
+		_, err := handleMsgCreateUniversalTransaction(ctx, keeper, types.NewMsgCreateUniversalTransaction(owners[0], wallet.Address, message, 0))
+		require.NoError(t, err)
+		universal, err := types.NewUniversalTransaction(wallet.Address, message, signers, ctx.BlockHeight(), ctx.TxBytes())
+		require.NoError(t, err)
+		for _, owner := range owners[1:] {
+			_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(owner, universal.ID), true)
+			require.NoError(t, err)
+		}
+	}
+
+	// signatures of pending transactions follow the owners before the indexes are built
+	execute(types.NewMsgRemoveOwner(wallet.Address, mul.Addrs[0]), 1, mul.Addrs[1], mul.Addrs[2])
+	require.Equal(t, []sdk.AccAddress{nil, nil}, keeper.GetTransaction(ctx, tx.ID).Signers)
+	execute(types.NewMsgAddOwner(wallet.Address, mul.Addrs[3], 1), 2, mul.Addrs[1], mul.Addrs[2])
+	require.Equal(t, []sdk.AccAddress{nil, nil, nil}, keeper.GetTransaction(ctx, tx.ID).Signers)
+
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], tx.ID), true)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, mul.Addrs[4]).IsZero())
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[3], tx.ID), true)
+	require.NoError(t, err)
+	require.Equal(t, coins, bankKeeper.GetCoins(ctx, mul.Addrs[4]))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
+)
+
+// MigrateIndexes builds secondary indexes for wallets and transactions stored before the indexes were introduced
+// and saves the statuses of transactions stored before statuses were introduced. It does nothing if the indexes
+// are already built.
+func (k Keeper) MigrateIndexes(ctx sdk.Context) {
+	if k.IsIndexed(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	k.storeLegacyStatuses(ctx, nil)
+	for _, wallet := range k.GetAllWallets(ctx) {
+		k.setWalletIndexes(ctx, wallet)
+	}
+	for _, transaction := range k.GetAllTransactions(ctx) {
+		k.setTransactionIndexes(ctx, transaction)
+	}
+	store.Set([]byte(types.IndexesVersionKey), []byte{1})
+}
+
+// IsIndexed returns true once the secondary indexes are built by MigrateIndexes, the indexes are kept
+// up to date since then. The flag is read without consuming gas, so that checking it doesn't change
+// the gas used by the transactions.
+func (k Keeper) IsIndexed(ctx sdk.Context) bool {
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	return store.Has([]byte(types.IndexesVersionKey))
+}
+
+// GetOwnerWalletAddresses returns addresses of multisig wallets owned by the address.
+// All wallets are iterated until the indexes are built.
+func (k Keeper) GetOwnerWalletAddresses(ctx sdk.Context, owner sdk.AccAddress) []string {
+	if !k.IsIndexed(ctx) {
+		var wallets []string
+		for _, wallet := range k.GetAllWallets(ctx) {
+			if wallet.OwnerIndex(owner) >= 0 {
+				wallets = append(wallets, wallet.Address.String())
+			}
+		}
+		return wallets
+	}
+
+	prefix := fmt.Sprintf("%s%s/", types.OwnerWalletPrefix, owner)
+	var wallets []string
+	iterator := k.GetIterator(ctx, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		wallets = append(wallets, string(iterator.Value()))
+	}
+	return wallets
+}
+
+// GetWalletTransactionIDs returns IDs of all transactions of the multisig wallet starting from the latest one.
+// All transactions are iterated until the indexes are built.
+func (k Keeper) GetWalletTransactionIDs(ctx sdk.Context, wallet sdk.AccAddress) []string {
+	if !k.IsIndexed(ctx) {
+		return k.scanTransactionIDs(ctx, wallet, false)
+	}
+	return k.getReversedValues(ctx, fmt.Sprintf("%s%s/", types.WalletTxPrefix, wallet))
+}
+
+// GetPendingTransactionIDs returns IDs of pending transactions of the multisig wallet starting from the latest one.
+// All transactions are iterated until the indexes are built.
+func (k Keeper) GetPendingTransactionIDs(ctx sdk.Context, wallet sdk.AccAddress) []string {
+	if !k.IsIndexed(ctx) {
+		return k.scanTransactionIDs(ctx, wallet, true)
+	}
+	return k.getReversedValues(ctx, fmt.Sprintf("%s%s/", types.PendingTxPrefix, wallet))
+}
+
+// scanTransactionIDs returns IDs of transactions of the multisig wallet in the order of the indexes
+// by iterating all transactions.
+func (k Keeper) scanTransactionIDs(ctx sdk.Context, wallet sdk.AccAddress, pending bool) []string {
+	var transactions []types.Transaction
+	for _, transaction := range k.GetAllTransactions(ctx) {
+		if !transaction.Wallet.Equals(wallet) || (pending && transaction.Status != types.TxStatusPending) {
+			continue
+		}
+		transactions = append(transactions, transaction)
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if transactions[i].CreatedAt != transactions[j].CreatedAt {
+			return transactions[i].CreatedAt > transactions[j].CreatedAt
+		}
+		return transactions[i].ID > transactions[j].ID
+	})
+
+	var txIDs []string
+	for _, transaction := range transactions {
+		txIDs = append(txIDs, transaction.ID)
+	}
+	return txIDs
+}
+
+func (k Keeper) getReversedValues(ctx sdk.Context, prefix string) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte(prefix))
+	defer iterator.Close()
+
+	var values []string
+	for ; iterator.Valid(); iterator.Next() {
+		values = append(values, string(iterator.Value()))
+	}
+	return values
+}
+
+func (k Keeper) setWalletIndexes(ctx sdk.Context, wallet types.Wallet) {
+	store := ctx.KVStore(k.storeKey)
+	for _, owner := range wallet.Owners {
+		store.Set(getOwnerWalletKey(owner, wallet.Address), []byte(wallet.Address.String()))
+	}
+}
+
+func (k Keeper) deleteWalletIndexes(ctx sdk.Context, wallet types.Wallet) {
+	store := ctx.KVStore(k.storeKey)
+	for _, owner := range wallet.Owners {
+		store.Delete(getOwnerWalletKey(owner, wallet.Address))
+	}
+}
+
+func (k Keeper) setTransactionIndexes(ctx sdk.Context, transaction types.Transaction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getWalletTxKey(types.WalletTxPrefix, transaction), []byte(transaction.ID))
+	if transaction.Status == types.TxStatusPending {
+		store.Set(getWalletTxKey(types.PendingTxPrefix, transaction), []byte(transaction.ID))
+	} else {
+		store.Delete(getWalletTxKey(types.PendingTxPrefix, transaction))
+	}
+}
+
+func getOwnerWalletKey(owner sdk.AccAddress, wallet sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s", types.OwnerWalletPrefix, owner, wallet))
+}
+
+func getWalletTxKey(prefix string, transaction types.Transaction) []byte {
+	return []byte(fmt.Sprintf("%s%s/%020d/%s", prefix, transaction.Wallet, transaction.CreatedAt, transaction.ID))
+}
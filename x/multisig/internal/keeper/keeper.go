@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 
@@ -21,10 +22,11 @@ type Keeper struct {
 	AccountKeeper auth.AccountKeeper
 	CoinKeeper    coin.Keeper
 	BankKeeper    bank.Keeper
+	router        sdk.Router
 }
 
 // NewKeeper creates a multisig keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspace, accountKeeper auth.AccountKeeper, coinKeeper coin.Keeper, bankKeeper bank.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspace, accountKeeper auth.AccountKeeper, coinKeeper coin.Keeper, bankKeeper bank.Keeper, router sdk.Router) Keeper {
 	keeper := Keeper{
 		storeKey:      key,
 		cdc:           cdc,
@@ -32,6 +34,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspac
 		AccountKeeper: accountKeeper,
 		CoinKeeper:    coinKeeper,
 		BankKeeper:    bankKeeper,
+		router:        router,
 	}
 	return keeper
 }
@@ -41,6 +44,35 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// Router returns the router used to execute messages wrapped into multisig transactions.
+func (k Keeper) Router() sdk.Router {
+	return k.router
+}
+
+// ExecuteMessage routes the message wrapped into multisig transaction to the handler of its module.
+// State changes are written only if the message is handled successfully.
+func (k Keeper) ExecuteMessage(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	if k.router == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "message router is not set")
+	}
+	handler := k.router.Route(ctx, msg.Route())
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unable to handle message %s/%s", msg.Route(), msg.Type())
+	}
+	write()
+
+	return res, nil
+}
+
 // GetIterator returns iterator over KVStore with specified prefix.
 func (k Keeper) GetIterator(ctx sdk.Context, prefix string) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -79,9 +111,36 @@ func (k Keeper) GetAllWallets(ctx sdk.Context) []types.Wallet {
 
 // SetWallet sets the entire wallet metadata struct for a multisig wallet.
 func (k Keeper) SetWallet(ctx sdk.Context, wallet types.Wallet) {
+	indexed := k.IsIndexed(ctx)
+	if indexed {
+		k.deleteWalletIndexes(ctx, k.GetWallet(ctx, wallet.Address.String()))
+	}
 	key := fmt.Sprintf("wallet/%s", wallet.Address.String())
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(wallet))
+	if indexed {
+		k.setWalletIndexes(ctx, wallet)
+	}
+}
+
+// UpdateWallet replaces owners, weights and threshold of existing multisig wallet.
+// Signatures of transactions which are not confirmed yet are rearranged according to the new owners.
+// Statuses of the wallet transactions stored before statuses were introduced are saved first, so that
+// the new weights and threshold don't change them.
+func (k Keeper) UpdateWallet(ctx sdk.Context, wallet types.Wallet) {
+	if !k.IsIndexed(ctx) {
+		k.storeLegacyStatuses(ctx, wallet.Address)
+	}
+	previous := k.GetWallet(ctx, wallet.Address.String())
+	for _, txID := range k.GetPendingTransactionIDs(ctx, wallet.Address) {
+		transaction := k.GetTransaction(ctx, txID)
+		if transaction.Status != types.TxStatusPending || previous.Confirmations(transaction.Signers) >= previous.Threshold {
+			continue
+		}
+		transaction.Signers = wallet.AlignSigners(transaction.Signers)
+		k.SetTransaction(ctx, transaction)
+	}
+	k.SetWallet(ctx, wallet)
 }
 
 // GetTransaction returns multisig wallet transaction metadata with specified address transaction ID.
@@ -94,7 +153,7 @@ func (k Keeper) GetTransaction(ctx sdk.Context, txID string) types.Transaction {
 	bz := store.Get([]byte(key))
 	var transaction types.Transaction
 	k.cdc.MustUnmarshalBinaryBare(bz, &transaction)
-	return transaction
+	return k.resolveStatus(ctx, transaction)
 }
 
 // SetTransaction sets the entire multisig wallet transaction metadata struct for a multisig wallet.
@@ -102,6 +161,9 @@ func (k Keeper) SetTransaction(ctx sdk.Context, transaction types.Transaction) {
 	key := fmt.Sprintf("tx/%s", transaction.ID)
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(transaction))
+	if k.IsIndexed(ctx) {
+		k.setTransactionIndexes(ctx, transaction)
+	}
 }
 
 func (k Keeper) GetAllTransactions(ctx sdk.Context) []types.Transaction {
@@ -115,8 +177,98 @@ func (k Keeper) GetAllTransactions(ctx sdk.Context) []types.Transaction {
 		if err != nil {
 			panic(err)
 		}
-		txs = append(txs, tx)
+		txs = append(txs, k.resolveStatus(ctx, tx))
 	}
 
 	return txs
 }
+
+// resolveStatus fills status of the transaction stored before statuses were introduced.
+// Such transactions are considered executed if they have enough signatures.
+func (k Keeper) resolveStatus(ctx sdk.Context, transaction types.Transaction) types.Transaction {
+	if len(transaction.Status) > 0 || transaction.Wallet.Empty() {
+		return transaction
+	}
+	wallet := k.GetWallet(ctx, transaction.Wallet.String())
+	if wallet.Confirmations(transaction.Signers) >= wallet.Threshold {
+		transaction.Status = types.TxStatusExecuted
+	} else {
+		transaction.Status = types.TxStatusPending
+	}
+	return transaction
+}
+
+// storeLegacyStatuses saves the statuses of the transactions stored before statuses were introduced,
+// resolved with the current weights and threshold of their wallets. Only the transactions of the wallet
+// are updated if the wallet is set.
+func (k Keeper) storeLegacyStatuses(ctx sdk.Context, wallet sdk.AccAddress) {
+	var transactions []types.Transaction
+	iterator := k.GetIterator(ctx, types.TxPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var transaction types.Transaction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &transaction)
+		if len(transaction.Status) > 0 || transaction.Wallet.Empty() {
+			continue
+		}
+		if !wallet.Empty() && !transaction.Wallet.Equals(wallet) {
+			continue
+		}
+		transactions = append(transactions, k.resolveStatus(ctx, transaction))
+	}
+	iterator.Close()
+
+	for _, transaction := range transactions {
+		k.SetTransaction(ctx, transaction)
+	}
+}
+
+// HasStoredStatus returns true if the status of the transaction is stored. Transactions stored before
+// statuses were introduced have no stored status until it is saved by MigrateIndexes or UpdateWallet.
+func (k Keeper) HasStoredStatus(ctx sdk.Context, txID string) bool {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(fmt.Sprintf("tx/%s", txID)))
+	if bz == nil {
+		return false
+	}
+	var transaction types.Transaction
+	k.cdc.MustUnmarshalBinaryBare(bz, &transaction)
+	return len(transaction.Status) > 0
+}
+
+// SetTransactionExpiration puts the transaction to the queue of transactions expiring at the specified height.
+func (k Keeper) SetTransactionExpiration(ctx sdk.Context, transaction types.Transaction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getExpirationKey(transaction.ExpiresAt, transaction.ID), []byte(transaction.ID))
+}
+
+// DeleteTransactionExpiration removes the transaction from the expiration queue.
+func (k Keeper) DeleteTransactionExpiration(ctx sdk.Context, transaction types.Transaction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getExpirationKey(transaction.ExpiresAt, transaction.ID))
+}
+
+// IterateExpiredTransactions iterates over transactions which expiration height is less than the specified one.
+func (k Keeper) IterateExpiredTransactions(ctx sdk.Context, height int64, cb func(transaction types.Transaction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator([]byte(types.ExpirationPrefix), getExpirationKey(height, ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.GetTransaction(ctx, string(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// FinishTransaction sets final status of the multisig transaction and removes it from the expiration queue.
+func (k Keeper) FinishTransaction(ctx sdk.Context, transaction types.Transaction, status string) types.Transaction {
+	if transaction.ExpiresAt > 0 {
+		k.DeleteTransactionExpiration(ctx, transaction)
+	}
+	transaction.Status = status
+	k.SetTransaction(ctx, transaction)
+	return transaction
+}
+
+func getExpirationKey(height int64, txID string) []byte {
+	return []byte(fmt.Sprintf("%s%020d/%s", types.ExpirationPrefix, height, txID))
+}
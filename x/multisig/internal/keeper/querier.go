@@ -2,10 +2,11 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
+	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,10 +16,11 @@ import (
 
 // Query endpoints supported by the multisig querier.
 const (
-	QueryListWallets      = "listWallets"
-	QueryGetWallet        = "getWallet"
-	QueryListTransactions = "listTransactions"
-	QueryGetTransaction   = "getTransaction"
+	QueryListWallets          = "listWallets"
+	QueryGetWallet            = "getWallet"
+	QueryListTransactions     = "listTransactions"
+	QueryGetTransaction       = "getTransaction"
+	QueryAwaitingTransactions = "awaitingTransactions"
 )
 
 // NewQuerier creates a new querier for multisig clients.
@@ -33,6 +35,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return listTransactions(ctx, path[1:], req, k)
 		case QueryGetTransaction:
 			return getTransaction(ctx, path[1:], req, k)
+		case QueryAwaitingTransactions:
+			return awaitingTransactions(ctx, path[1:], req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown multisig query endpoint")
 		}
@@ -40,25 +44,27 @@ func NewQuerier(k Keeper) sdk.Querier {
 }
 
 func listWallets(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	var wallets types.QueryWallets
+	wallets := types.QueryWallets{}
 
-	owner, err := sdk.AccAddressFromBech32(path[0])
+	var params types.QueryWalletsParams
+	err := parseQueryParams(keeper.cdc, req, &params)
 	if err != nil {
-		msgError := fmt.Sprintf("unable to parse owner address: %s", err.Error())
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msgError)
+		return nil, err
 	}
-
-	for iterator := keeper.GetIterator(ctx, "wallet/"); iterator.Valid(); iterator.Next() {
-		address := strings.TrimPrefix(string(iterator.Key()), "wallet/")
-		wallet := keeper.GetWallet(ctx, address)
-		for _, o := range wallet.Owners {
-			if o.Equals(owner) {
-				wallets = append(wallets, wallet)
-				break
-			}
+	if len(path) > 0 {
+		params.Owner, err = sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			msgError := fmt.Sprintf("unable to parse owner address: %s", err.Error())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msgError)
 		}
 	}
 
+	addresses := keeper.GetOwnerWalletAddresses(ctx, params.Owner)
+	start, end := paginate(len(addresses), params.Page, params.Limit)
+	for _, address := range addresses[start:end] {
+		wallets = append(wallets, keeper.GetWallet(ctx, address))
+	}
+
 	res, err := codec.MarshalJSONIndent(keeper.cdc, wallets)
 	if err != nil {
 		panic("could not marshal result to JSON")
@@ -80,22 +86,45 @@ func getWallet(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 }
 
 func listTransactions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	var transactionList types.QueryTransactions
+	transactionList := types.QueryTransactions{}
 
-	wallet, err := sdk.AccAddressFromBech32(path[0])
+	var params types.QueryTransactionsParams
+	err := parseQueryParams(keeper.cdc, req, &params)
 	if err != nil {
-		msgError := fmt.Sprintf("unable to parse wallet address: %s", err.Error())
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msgError)
+		return nil, err
+	}
+	if len(path) > 0 {
+		params.Wallet, err = sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			msgError := fmt.Sprintf("unable to parse wallet address: %s", err.Error())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msgError)
+		}
+	}
+	if len(params.Status) > 0 && !types.IsValidTxStatus(params.Status) {
+		msgError := fmt.Sprintf("unknown multisig transaction status: %s", params.Status)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, msgError)
 	}
 
-	for iterator := keeper.GetIterator(ctx, "transaction/"); iterator.Valid(); iterator.Next() {
-		txID := strings.TrimPrefix(string(iterator.Key()), "transaction/")
-		transaction := keeper.GetTransaction(ctx, txID)
-		if transaction.Wallet.Equals(wallet) {
-			transactionList = append(transactionList, transaction)
+	var txIDs []string
+	switch params.Status {
+	case "":
+		txIDs = keeper.GetWalletTransactionIDs(ctx, params.Wallet)
+	case types.TxStatusPending:
+		txIDs = keeper.GetPendingTransactionIDs(ctx, params.Wallet)
+	default:
+		// Transactions with final statuses are not indexed separately
+		for _, txID := range keeper.GetWalletTransactionIDs(ctx, params.Wallet) {
+			if keeper.GetTransaction(ctx, txID).Status == params.Status {
+				txIDs = append(txIDs, txID)
+			}
 		}
 	}
 
+	start, end := paginate(len(txIDs), params.Page, params.Limit)
+	for _, txID := range txIDs[start:end] {
+		transactionList = append(transactionList, keeper.GetTransaction(ctx, txID))
+	}
+
 	res, err := codec.MarshalJSONIndent(keeper.cdc, transactionList)
 	if err != nil {
 		panic("could not marshal result to JSON")
@@ -115,3 +144,76 @@ func getTransaction(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 
 	return res, nil
 }
+
+// awaitingTransactions returns pending transactions of all wallets owned by the address which are not signed by it yet.
+func awaitingTransactions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	transactionList := types.QueryTransactions{}
+
+	var params types.QueryWalletsParams
+	err := parseQueryParams(keeper.cdc, req, &params)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) > 0 {
+		params.Owner, err = sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			msgError := fmt.Sprintf("unable to parse owner address: %s", err.Error())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msgError)
+		}
+	}
+
+	for _, address := range keeper.GetOwnerWalletAddresses(ctx, params.Owner) {
+		wallet := keeper.GetWallet(ctx, address)
+		index := wallet.OwnerIndex(params.Owner)
+		if index < 0 {
+			continue
+		}
+		for _, txID := range keeper.GetPendingTransactionIDs(ctx, wallet.Address) {
+			transaction := keeper.GetTransaction(ctx, txID)
+			if transaction.IsExpired(ctx.BlockHeight()) {
+				continue
+			}
+			if index < len(transaction.Signers) && !transaction.Signers[index].Empty() {
+				continue
+			}
+			transactionList = append(transactionList, transaction)
+		}
+	}
+
+	// Latest transactions go first
+	sort.SliceStable(transactionList, func(i, j int) bool {
+		return transactionList[i].CreatedAt > transactionList[j].CreatedAt
+	})
+
+	start, end := paginate(len(transactionList), params.Page, params.Limit)
+	res, err := codec.MarshalJSONIndent(keeper.cdc, transactionList[start:end])
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return res, nil
+}
+
+// parseQueryParams unmarshals optional query params from the request data.
+func parseQueryParams(cdc *codec.Codec, req abci.RequestQuery, params interface{}) error {
+	if len(req.Data) == 0 {
+		return nil
+	}
+	err := cdc.UnmarshalJSON(req.Data, params)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	return nil
+}
+
+// paginate returns bounds of the requested page, the first page is returned by default.
+func paginate(count, page, limit int) (start, end int) {
+	if page <= 0 {
+		page = 1
+	}
+	start, end = client.Paginate(count, page, limit, types.DefaultQueryLimit)
+	if start < 0 || end < 0 {
+		return 0, 0
+	}
+	return start, end
+}
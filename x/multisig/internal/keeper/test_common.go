@@ -12,6 +12,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,12 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(types.MsgCreateWallet{}, "test/coin/create_wallet", nil)
 	cdc.RegisterConcrete(types.MsgCreateTransaction{}, "test/coin/create_transaction", nil)
 	cdc.RegisterConcrete(types.MsgSignTransaction{}, "test/coin/sign_transaction", nil)
+	cdc.RegisterConcrete(types.MsgCreateUniversalTransaction{}, "test/coin/create_universal_transaction", nil)
+	cdc.RegisterConcrete(types.MsgAddOwner{}, "test/coin/add_owner", nil)
+	cdc.RegisterConcrete(types.MsgRemoveOwner{}, "test/coin/remove_owner", nil)
+	cdc.RegisterConcrete(types.MsgChangeWeight{}, "test/coin/change_weight", nil)
+	cdc.RegisterConcrete(types.MsgChangeThreshold{}, "test/coin/change_threshold", nil)
+	cdc.RegisterConcrete(bank.MsgSend{}, "test/bank/send", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*authexported.Account)(nil), nil)
@@ -53,6 +60,16 @@ func MakeTestCodec() *codec.Codec {
 // `initPower` is converted to an amount of tokens.
 // If `initPower` is 0, no addrs get created.
 func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, coin.Keeper, auth.AccountKeeper, bank.Keeper) {
+	return createTestInput(t, isCheckTx, true)
+}
+
+// CreateLegacyTestInput creates the same input with the store of the node running before the indexes
+// and the transaction statuses were introduced.
+func CreateLegacyTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, coin.Keeper, auth.AccountKeeper, bank.Keeper) {
+	return createTestInput(t, isCheckTx, false)
+}
+
+func createTestInput(t *testing.T, isCheckTx bool, indexed bool) (sdk.Context, Keeper, coin.Keeper, auth.AccountKeeper, bank.Keeper) {
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
@@ -86,6 +103,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, coin.Ke
 
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	bankKeeper.SetSendEnabled(ctx, true)
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, make(map[string][]string))
 	coinKeeper := coin.NewKeeper(cdc, keyCoin, pk.Subspace(coin.DefaultParamspace), accountKeeper, bankKeeper, supplyKeeper, config.GetDefaultConfig(config.ChainID))
 
@@ -96,7 +114,13 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, coin.Ke
 		Volume: coinConfig.InitialVolumeBaseCoin,
 	})
 
-	multisigKeeper := NewKeeper(cdc, keyMultisig, pk.Subspace(types.DefaultParamspace), accountKeeper, coinKeeper, bankKeeper)
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bank.NewHandler(bankKeeper))
+
+	multisigKeeper := NewKeeper(cdc, keyMultisig, pk.Subspace(types.DefaultParamspace), accountKeeper, coinKeeper, bankKeeper, router)
+	if indexed {
+		multisigKeeper.MigrateIndexes(ctx)
+	}
 
 	return ctx, multisigKeeper, coinKeeper, accountKeeper, bankKeeper
 }
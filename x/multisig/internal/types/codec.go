@@ -9,6 +9,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateWallet{}, "multisig/create_wallet", nil)
 	cdc.RegisterConcrete(MsgCreateTransaction{}, "multisig/create_transaction", nil)
 	cdc.RegisterConcrete(MsgSignTransaction{}, "multisig/sign_transaction", nil)
+	cdc.RegisterConcrete(MsgCreateUniversalTransaction{}, "multisig/create_universal_transaction", nil)
+	cdc.RegisterConcrete(MsgAddOwner{}, "multisig/add_owner", nil)
+	cdc.RegisterConcrete(MsgRemoveOwner{}, "multisig/remove_owner", nil)
+	cdc.RegisterConcrete(MsgChangeWeight{}, "multisig/change_weight", nil)
+	cdc.RegisterConcrete(MsgChangeThreshold{}, "multisig/change_threshold", nil)
+	cdc.RegisterConcrete(MsgRevokeSignature{}, "multisig/revoke_signature", nil)
+	cdc.RegisterConcrete(MsgCancelTransaction{}, "multisig/cancel_transaction", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -24,6 +24,15 @@ const (
 	CodeWalletAccountNotFound CodeType = 108
 	CodeInsufficientFunds     CodeType = 109
 	CodeDuplicateOwner        CodeType = 110
+	CodeInvalidMessage        CodeType = 111
+	CodeInvalidThreshold      CodeType = 112
+	CodeOwnerAlreadyExists    CodeType = 113
+	CodeOwnerNotFound         CodeType = 114
+	CodeTransactionNotPending CodeType = 115
+	CodeTransactionExpired    CodeType = 116
+	CodeInvalidExpiration     CodeType = 117
+	CodeSignatureNotFound     CodeType = 118
+	CodeAlreadyVoted          CodeType = 119
 )
 
 func ErrInvalidSender() *sdkerrors.Error {
@@ -112,3 +121,89 @@ func ErrDuplicateOwner(address string) *sdkerrors.Error {
 		errors.NewParam("address", address),
 	)
 }
+
+func ErrInvalidMessage(reason string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidMessage,
+		fmt.Sprintf("Invalid wrapped message: %s", reason),
+		errors.NewParam("reason", reason),
+	)
+}
+
+func ErrInvalidThreshold(threshold string, weightsSum string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidThreshold,
+		fmt.Sprintf("Invalid threshold: threshold (%s) must be positive and not greater than sum of weights (%s)", threshold, weightsSum),
+		errors.NewParam("threshold", threshold),
+		errors.NewParam("weightsSum", weightsSum),
+	)
+}
+
+func ErrOwnerAlreadyExists(address string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeOwnerAlreadyExists,
+		fmt.Sprintf("Invalid owner: address %s is already an owner of the wallet", address),
+		errors.NewParam("address", address),
+	)
+}
+
+func ErrOwnerNotFound(address string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeOwnerNotFound,
+		fmt.Sprintf("Invalid owner: address %s is not an owner of the wallet", address),
+		errors.NewParam("address", address),
+	)
+}
+
+func ErrTransactionNotPending(txID string, status string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeTransactionNotPending,
+		fmt.Sprintf("Multi-signature transaction %s is not pending: status is %s", txID, status),
+		errors.NewParam("txID", txID),
+		errors.NewParam("status", status),
+	)
+}
+
+func ErrTransactionExpired(txID string, expiresAt string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeTransactionExpired,
+		fmt.Sprintf("Multi-signature transaction %s is expired at block %s", txID, expiresAt),
+		errors.NewParam("txID", txID),
+		errors.NewParam("expiresAt", expiresAt),
+	)
+}
+
+func ErrInvalidExpiration(expiresAt string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidExpiration,
+		fmt.Sprintf("Invalid expiration: block height %s is already passed", expiresAt),
+		errors.NewParam("expiresAt", expiresAt),
+	)
+}
+
+func ErrSignatureNotFound(txID string, address string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeSignatureNotFound,
+		fmt.Sprintf("Multi-signature transaction %s is not signed by %s", txID, address),
+		errors.NewParam("txID", txID),
+		errors.NewParam("address", address),
+	)
+}
+
+func ErrAlreadyVoted(txID string, address string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeAlreadyVoted,
+		fmt.Sprintf("Cancellation of multi-signature transaction %s is already voted by %s", txID, address),
+		errors.NewParam("txID", txID),
+		errors.NewParam("address", address),
+	)
+}
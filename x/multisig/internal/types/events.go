@@ -6,6 +6,15 @@ const (
 	EventTypeCreateTransaction = "create_transaction"
 	EventTypeSignTransaction   = "sign_transaction"
 
+	EventTypeCreateUniversalTransaction = "create_universal_transaction"
+	EventTypeAddOwner                   = "add_owner"
+	EventTypeRemoveOwner                = "remove_owner"
+	EventTypeChangeWeight               = "change_weight"
+	EventTypeChangeThreshold            = "change_threshold"
+	EventTypeRevokeSignature            = "revoke_signature"
+	EventTypeCancelTransaction          = "cancel_transaction"
+	EventTypeExpireTransaction          = "expire_transaction"
+
 	// Common
 	AttributeKeySender      = "sender"
 	AttributeKeyWallet      = "wallet"
@@ -17,14 +26,28 @@ const (
 	AttributeKeyThreshold = "threshold"
 
 	// CreateTransaction
-	AttributeKeyReceiver = "receiver"
-	AttributeKeyCoins    = "coins"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeyCoins     = "coins"
+	AttributeKeyExpiresAt = "expires_at"
+
+	// CreateUniversalTransaction
+	AttributeKeyMessageRoute = "message_route"
+	AttributeKeyMessageType  = "message_type"
+
+	// Wallet management
+	AttributeKeyOwner  = "owner"
+	AttributeKeyWeight = "weight"
 
 	// SignTransaction
 	AttributeKeySigner        = "signer"
 	AttributeKeySignerWeight  = "signer_weight"
 	AttributeKeyConfirmations = "confirmations"
 	AttributeKeyConfirmed     = "confirmed"
+	AttributeKeyResultLog     = "result_log"
+
+	// CancelTransaction
+	AttributeKeyCancellations = "cancellations"
+	AttributeKeyStatus        = "status"
 
 	AttributeValueCategory = ModuleName
 )
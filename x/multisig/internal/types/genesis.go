@@ -4,6 +4,7 @@ package types
 type GenesisState struct {
 	Wallets []Wallet      `json:"wallets"`
 	Txs     []Transaction `json:"txs"`
+	Indexed bool          `json:"indexed,omitempty"` // the secondary indexes of the wallets and transactions are built
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -19,6 +20,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Wallets: make([]Wallet, 0),
 		Txs:     make([]Transaction, 0),
+		Indexed: true,
 	}
 }
 
@@ -15,4 +15,14 @@ const (
 	WalletPrefix = "wallet/"
 
 	TxPrefix = "tx/"
+
+	ExpirationPrefix = "expiration/"
+
+	// Secondary indexes
+	OwnerWalletPrefix = "owner_wallet/" // owner_wallet/<owner>/<wallet>
+	WalletTxPrefix    = "wallet_tx/"    // wallet_tx/<wallet>/<height>/<tx_id>
+	PendingTxPrefix   = "pending_tx/"   // pending_tx/<wallet>/<height>/<tx_id>
+
+	// IndexesVersionKey is set once secondary indexes are built for all stored wallets and transactions
+	IndexesVersionKey = "indexes_version"
 )
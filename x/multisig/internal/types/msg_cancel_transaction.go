@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCancelTransaction{}
+
+// MsgCancelTransaction defines a CancelTransaction message to cancel existing transaction for multisignature wallet or vote for its cancellation.
+type MsgCancelTransaction struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	TxID   string         `json:"tx_id" yaml:"tx_id"`
+}
+
+// NewMsgCancelTransaction creates a new MsgCancelTransaction instance.
+func NewMsgCancelTransaction(sender sdk.AccAddress, txID string) MsgCancelTransaction {
+	return MsgCancelTransaction{
+		Sender: sender,
+		TxID:   txID,
+	}
+}
+
+const CancelTransactionConst = "cancel_transaction"
+
+// Route returns name of the route for the message.
+func (msg MsgCancelTransaction) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgCancelTransaction) Type() string { return CancelTransactionConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgCancelTransaction) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return ErrInvalidSender()
+	}
+	if len(msg.TxID) == 0 {
+		return ErrInvalidMessage("transaction ID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+func (msg MsgCancelTransaction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgCancelTransaction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
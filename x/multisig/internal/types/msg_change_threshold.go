@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ WalletMsg = &MsgChangeThreshold{}
+
+// MsgChangeThreshold defines a ChangeThreshold message to change threshold of the multisignature wallet.
+// The message is signed by the wallet itself so it can be executed only within confirmed multisig transaction.
+type MsgChangeThreshold struct {
+	Wallet    sdk.AccAddress `json:"wallet" yaml:"wallet"`
+	Threshold uint           `json:"threshold" yaml:"threshold"`
+}
+
+// NewMsgChangeThreshold creates a new MsgChangeThreshold instance.
+func NewMsgChangeThreshold(wallet sdk.AccAddress, threshold uint) MsgChangeThreshold {
+	return MsgChangeThreshold{
+		Wallet:    wallet,
+		Threshold: threshold,
+	}
+}
+
+const ChangeThresholdConst = "change_threshold"
+
+// Route returns name of the route for the message.
+func (msg MsgChangeThreshold) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgChangeThreshold) Type() string { return ChangeThresholdConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgChangeThreshold) ValidateBasic() error {
+	if msg.Wallet.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "wallet address cannot be empty")
+	}
+	if msg.Threshold == 0 {
+		return ErrInvalidThreshold("0", "0")
+	}
+	return nil
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+func (msg MsgChangeThreshold) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgChangeThreshold) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Wallet}
+}
+
+// GetWallet returns address of the managed multisig wallet.
+func (msg MsgChangeThreshold) GetWallet() sdk.AccAddress {
+	return msg.Wallet
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ WalletMsg = &MsgChangeWeight{}
+
+// MsgChangeWeight defines a ChangeWeight message to change weight of existing owner of the multisignature wallet.
+// The message is signed by the wallet itself so it can be executed only within confirmed multisig transaction.
+type MsgChangeWeight struct {
+	Wallet sdk.AccAddress `json:"wallet" yaml:"wallet"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Weight uint           `json:"weight" yaml:"weight"`
+}
+
+// NewMsgChangeWeight creates a new MsgChangeWeight instance.
+func NewMsgChangeWeight(wallet sdk.AccAddress, owner sdk.AccAddress, weight uint) MsgChangeWeight {
+	return MsgChangeWeight{
+		Wallet: wallet,
+		Owner:  owner,
+		Weight: weight,
+	}
+}
+
+const ChangeWeightConst = "change_weight"
+
+// Route returns name of the route for the message.
+func (msg MsgChangeWeight) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgChangeWeight) Type() string { return ChangeWeightConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgChangeWeight) ValidateBasic() error {
+	if msg.Wallet.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "wallet address cannot be empty")
+	}
+	if msg.Owner.Empty() {
+		return ErrInvalidOwner()
+	}
+	if msg.Weight < MinWeight {
+		return ErrInvalidWeight(strconv.Itoa(MinWeight), "less")
+	}
+	if msg.Weight > MaxWeight {
+		return ErrInvalidWeight(strconv.Itoa(MaxWeight), "greater")
+	}
+	return nil
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+func (msg MsgChangeWeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgChangeWeight) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Wallet}
+}
+
+// GetWallet returns address of the managed multisig wallet.
+func (msg MsgChangeWeight) GetWallet() sdk.AccAddress {
+	return msg.Wallet
+}
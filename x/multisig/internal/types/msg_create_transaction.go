@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,15 +14,18 @@ type MsgCreateTransaction struct {
 	Wallet   sdk.AccAddress `json:"wallet" yaml:"wallet"`
 	Receiver sdk.AccAddress `json:"receiver" yaml:"receiver"`
 	Coins    sdk.Coins      `json:"coins" yaml:"coins"`
+	// ExpiresAt is the last block height when the transaction can be signed (0 means it never expires)
+	ExpiresAt int64 `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
 }
 
 // NewMsgCreateTransaction creates a new MsgCreateTransaction instance.
-func NewMsgCreateTransaction(sender sdk.AccAddress, wallet sdk.AccAddress, receiver sdk.AccAddress, coins sdk.Coins) MsgCreateTransaction {
+func NewMsgCreateTransaction(sender sdk.AccAddress, wallet sdk.AccAddress, receiver sdk.AccAddress, coins sdk.Coins, expiresAt int64) MsgCreateTransaction {
 	return MsgCreateTransaction{
-		Sender:   sender,
-		Wallet:   wallet,
-		Receiver: receiver,
-		Coins:    coins,
+		Sender:    sender,
+		Wallet:    wallet,
+		Receiver:  receiver,
+		Coins:     coins,
+		ExpiresAt: expiresAt,
 	}
 }
 
@@ -34,7 +39,9 @@ func (msg MsgCreateTransaction) Type() string { return CreateTransactionConst }
 
 // ValidateBasic performs basic validation of the message.
 func (msg MsgCreateTransaction) ValidateBasic() error {
-	// TODO
+	if msg.ExpiresAt < 0 {
+		return ErrInvalidExpiration(strconv.FormatInt(msg.ExpiresAt, 10))
+	}
 	return nil
 }
 
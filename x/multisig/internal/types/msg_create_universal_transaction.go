@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateUniversalTransaction{}
+
+// MsgCreateUniversalTransaction defines a message to create new transaction for multisignature wallet
+// which executes arbitrary message on behalf of the wallet when it is confirmed.
+type MsgCreateUniversalTransaction struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Wallet  sdk.AccAddress `json:"wallet" yaml:"wallet"`
+	Message sdk.Msg        `json:"message" yaml:"message"`
+	// ExpiresAt is the last block height when the transaction can be signed (0 means it never expires)
+	ExpiresAt int64 `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+}
+
+// NewMsgCreateUniversalTransaction creates a new MsgCreateUniversalTransaction instance.
+func NewMsgCreateUniversalTransaction(sender sdk.AccAddress, wallet sdk.AccAddress, message sdk.Msg, expiresAt int64) MsgCreateUniversalTransaction {
+	return MsgCreateUniversalTransaction{
+		Sender:    sender,
+		Wallet:    wallet,
+		Message:   message,
+		ExpiresAt: expiresAt,
+	}
+}
+
+const CreateUniversalTransactionConst = "create_universal_transaction"
+
+// Route returns name of the route for the message.
+func (msg MsgCreateUniversalTransaction) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgCreateUniversalTransaction) Type() string { return CreateUniversalTransactionConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgCreateUniversalTransaction) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return ErrInvalidSender()
+	}
+	if msg.Wallet.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "wallet address cannot be empty")
+	}
+	if msg.ExpiresAt < 0 {
+		return ErrInvalidExpiration(strconv.FormatInt(msg.ExpiresAt, 10))
+	}
+	return ValidateWalletMessage(msg.Wallet, msg.Message)
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+// Wrapped message is represented with its own sign bytes since it is not known to the module codec.
+func (msg MsgCreateUniversalTransaction) GetSignBytes() []byte {
+	var message json.RawMessage
+	if msg.Message != nil {
+		message = msg.Message.GetSignBytes()
+	}
+	bz, err := json.Marshal(struct {
+		Sender    sdk.AccAddress  `json:"sender"`
+		Wallet    sdk.AccAddress  `json:"wallet"`
+		Message   json.RawMessage `json:"message"`
+		ExpiresAt int64           `json:"expires_at,omitempty"`
+	}{
+		Sender:    msg.Sender,
+		Wallet:    msg.Wallet,
+		Message:   message,
+		ExpiresAt: msg.ExpiresAt,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgCreateUniversalTransaction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// WalletMsg is implemented by messages managing the multisig wallet itself.
+// These are the only multisig messages allowed to be executed on behalf of the wallet.
+type WalletMsg interface {
+	sdk.Msg
+	GetWallet() sdk.AccAddress
+}
+
+// ValidateWalletMessage checks the message can be executed on behalf of the multisig wallet.
+func ValidateWalletMessage(wallet sdk.AccAddress, message sdk.Msg) error {
+	if message == nil {
+		return ErrInvalidMessage("message cannot be empty")
+	}
+	if _, ok := message.(WalletMsg); !ok && message.Route() == RouterKey {
+		return ErrInvalidMessage("multisig messages cannot be wrapped")
+	}
+	signers := message.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(wallet) {
+		return ErrInvalidMessage("the only signer of the message must be the wallet")
+	}
+	return message.ValidateBasic()
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ WalletMsg = &MsgRemoveOwner{}
+
+// MsgRemoveOwner defines a RemoveOwner message to remove existing owner from the multisignature wallet.
+// The message is signed by the wallet itself so it can be executed only within confirmed multisig transaction.
+type MsgRemoveOwner struct {
+	Wallet sdk.AccAddress `json:"wallet" yaml:"wallet"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewMsgRemoveOwner creates a new MsgRemoveOwner instance.
+func NewMsgRemoveOwner(wallet sdk.AccAddress, owner sdk.AccAddress) MsgRemoveOwner {
+	return MsgRemoveOwner{
+		Wallet: wallet,
+		Owner:  owner,
+	}
+}
+
+const RemoveOwnerConst = "remove_owner"
+
+// Route returns name of the route for the message.
+func (msg MsgRemoveOwner) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgRemoveOwner) Type() string { return RemoveOwnerConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgRemoveOwner) ValidateBasic() error {
+	if msg.Wallet.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "wallet address cannot be empty")
+	}
+	if msg.Owner.Empty() {
+		return ErrInvalidOwner()
+	}
+	return nil
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+func (msg MsgRemoveOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgRemoveOwner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Wallet}
+}
+
+// GetWallet returns address of the managed multisig wallet.
+func (msg MsgRemoveOwner) GetWallet() sdk.AccAddress {
+	return msg.Wallet
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRevokeSignature{}
+
+// MsgRevokeSignature defines a RevokeSignature message to revoke signature of existing transaction for multisignature wallet.
+type MsgRevokeSignature struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	TxID   string         `json:"tx_id" yaml:"tx_id"`
+}
+
+// NewMsgRevokeSignature creates a new MsgRevokeSignature instance.
+func NewMsgRevokeSignature(sender sdk.AccAddress, txID string) MsgRevokeSignature {
+	return MsgRevokeSignature{
+		Sender: sender,
+		TxID:   txID,
+	}
+}
+
+const RevokeSignatureConst = "revoke_signature"
+
+// Route returns name of the route for the message.
+func (msg MsgRevokeSignature) Route() string { return RouterKey }
+
+// Type returns the name of the type for the message.
+func (msg MsgRevokeSignature) Type() string { return RevokeSignatureConst }
+
+// ValidateBasic performs basic validation of the message.
+func (msg MsgRevokeSignature) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return ErrInvalidSender()
+	}
+	if len(msg.TxID) == 0 {
+		return ErrInvalidMessage("transaction ID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes returns the canonical byte representation of the message used to generate a signature.
+func (msg MsgRevokeSignature) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the list of signers required to sign the message.
+func (msg MsgRevokeSignature) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
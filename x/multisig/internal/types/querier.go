@@ -1,6 +1,57 @@
 package types
 
-import "strings"
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultQueryLimit is the default number of items returned by paginated queries.
+const DefaultQueryLimit = 100
+
+// QueryWalletsParams defines the params for querying wallets owned by the address.
+type QueryWalletsParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Page  int            `json:"page" yaml:"page"`
+	Limit int            `json:"limit" yaml:"limit"`
+}
+
+// NewQueryWalletsParams creates a new instance of QueryWalletsParams.
+func NewQueryWalletsParams(owner sdk.AccAddress, page, limit int) QueryWalletsParams {
+	return QueryWalletsParams{
+		Owner: owner,
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// QueryTransactionsParams defines the params for querying transactions of the wallet.
+// Transactions with any status are returned if the status is empty.
+type QueryTransactionsParams struct {
+	Wallet sdk.AccAddress `json:"wallet" yaml:"wallet"`
+	Status string         `json:"status" yaml:"status"`
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+}
+
+// NewQueryTransactionsParams creates a new instance of QueryTransactionsParams.
+func NewQueryTransactionsParams(wallet sdk.AccAddress, status string, page, limit int) QueryTransactionsParams {
+	return QueryTransactionsParams{
+		Wallet: wallet,
+		Status: status,
+		Page:   page,
+		Limit:  limit,
+	}
+}
+
+// IsValidTxStatus returns true if the status is known multisig transaction status.
+func IsValidTxStatus(status string) bool {
+	switch status {
+	case TxStatusPending, TxStatusExecuted, TxStatusExpired, TxStatusCancelled:
+		return true
+	}
+	return false
+}
 
 // QueryWallets specifies type containing set of multisig wallets.
 type QueryWallets []Wallet
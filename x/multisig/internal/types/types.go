@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 
 	"golang.org/x/crypto/sha3"
 
@@ -13,6 +14,14 @@ import (
 // MultisigTransactionIDPrefix is prefix for multisig transaction ID.
 const MultisigTransactionIDPrefix = "dxmstx"
 
+// Multisig transaction statuses.
+const (
+	TxStatusPending   = "pending"
+	TxStatusExecuted  = "executed"
+	TxStatusExpired   = "expired"
+	TxStatusCancelled = "cancelled"
+)
+
 ////////////////////////////////////////////////////////////////
 // Multisig Wallet
 ////////////////////////////////////////////////////////////////
@@ -59,6 +68,83 @@ func (w *Wallet) String() string {
 	return fmt.Sprintf(`Wallet %s: (%d of %d)`, w.Address, w.Threshold, weightsSum)
 }
 
+// Validate checks owners, weights and threshold of the wallet are consistent
+// and the threshold is reachable with signatures of all owners.
+func (w Wallet) Validate() error {
+	if len(w.Owners) < MinOwnerCount {
+		return ErrInvalidOwnerCount(false)
+	}
+	if len(w.Owners) > MaxOwnerCount {
+		return ErrInvalidOwnerCount(true)
+	}
+	if len(w.Owners) != len(w.Weights) {
+		return ErrInvalidWeightCount(strconv.Itoa(len(w.Weights)), strconv.Itoa(len(w.Owners)))
+	}
+	owners := make(map[string]bool, len(w.Owners))
+	for _, owner := range w.Owners {
+		if owner.Empty() {
+			return ErrInvalidOwner()
+		}
+		if owners[owner.String()] {
+			return ErrDuplicateOwner(owner.String())
+		}
+		owners[owner.String()] = true
+	}
+	weightsSum := uint(0)
+	for _, weight := range w.Weights {
+		if weight < MinWeight {
+			return ErrInvalidWeight(strconv.Itoa(MinWeight), "less")
+		}
+		if weight > MaxWeight {
+			return ErrInvalidWeight(strconv.Itoa(MaxWeight), "greater")
+		}
+		weightsSum += weight
+	}
+	if w.Threshold == 0 || w.Threshold > weightsSum {
+		return ErrInvalidThreshold(strconv.FormatUint(uint64(w.Threshold), 10), strconv.FormatUint(uint64(weightsSum), 10))
+	}
+	return nil
+}
+
+// OwnerIndex returns index of the owner in the wallet or -1 if the address is not an owner.
+func (w Wallet) OwnerIndex(owner sdk.AccAddress) int {
+	for i, o := range w.Owners {
+		if o.Equals(owner) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Confirmations returns summary weight of the wallet owners presented in the signers list.
+func (w Wallet) Confirmations(signers []sdk.AccAddress) uint {
+	confirmations := uint(0)
+	for _, signer := range signers {
+		if signer.Empty() {
+			continue
+		}
+		if i := w.OwnerIndex(signer); i >= 0 {
+			confirmations += w.Weights[i]
+		}
+	}
+	return confirmations
+}
+
+// AlignSigners returns the signers list arranged according to the current wallet owners
+// so signatures of removed owners are dropped and new owners get empty slots.
+func (w Wallet) AlignSigners(signers []sdk.AccAddress) []sdk.AccAddress {
+	aligned := make([]sdk.AccAddress, len(w.Owners))
+	for _, signer := range signers {
+		if signer.Empty() {
+			continue
+		}
+		if i := w.OwnerIndex(signer); i >= 0 {
+			aligned[i] = signer
+		}
+	}
+	return aligned
+}
+
 ////////////////////////////////////////////////////////////////
 // Multisig Transaction
 ////////////////////////////////////////////////////////////////
@@ -71,6 +157,14 @@ type Transaction struct {
 	Coins     sdk.Coins        `json:"coins" yaml:"coins"`
 	Signers   []sdk.AccAddress `json:"signers" yaml:"signers"`
 	CreatedAt int64            `json:"created_at" yaml:"created_at"` // block height
+	// Message is executed on behalf of the wallet instead of sending coins when it is set
+	Message sdk.Msg `json:"message,omitempty" yaml:"message,omitempty"`
+	// Creator is allowed to cancel the transaction without voting of other owners
+	Creator   sdk.AccAddress `json:"creator,omitempty" yaml:"creator,omitempty"`
+	ExpiresAt int64          `json:"expires_at,omitempty" yaml:"expires_at,omitempty"` // block height
+	Status    string         `json:"status" yaml:"status"`
+	// Cancellations contains owners voted for cancellation of the transaction
+	Cancellations []sdk.AccAddress `json:"cancellations,omitempty" yaml:"cancellations,omitempty"`
 }
 
 // NewTransaction returns a new Transaction.
@@ -104,10 +198,52 @@ func NewTransaction(wallet, receiver sdk.AccAddress, coins sdk.Coins, signers []
 		Coins:     coins,
 		Signers:   signers,
 		CreatedAt: height,
+		Status:    TxStatusPending,
 	}, nil
 }
 
+// NewUniversalTransaction returns a new Transaction executing the message.
+func NewUniversalTransaction(wallet sdk.AccAddress, message sdk.Msg, signers []sdk.AccAddress, height int64, salt []byte) (*Transaction, error) {
+
+	transactionMetadata := struct {
+		Wallet    sdk.AccAddress   `json:"wallet" yaml:"wallet"`
+		Message   []byte           `json:"message" yaml:"message"`
+		Signers   []sdk.AccAddress `json:"signers" yaml:"signers"`
+		CreatedAt int64            `json:"created_at" yaml:"created_at"` // block height
+		Salt      []byte           `json:"salt" yaml:"salt"`
+	}{
+		Wallet:    wallet,
+		Message:   message.GetSignBytes(),
+		Signers:   signers,
+		CreatedAt: height,
+		Salt:      salt,
+	}
+	bz := sha3.Sum256(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(transactionMetadata)))
+	id, err := bech32.ConvertAndEncode(MultisigTransactionIDPrefix, bz[12:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transaction{
+		ID:        id,
+		Wallet:    wallet,
+		Coins:     sdk.NewCoins(),
+		Signers:   signers,
+		CreatedAt: height,
+		Message:   message,
+		Status:    TxStatusPending,
+	}, nil
+}
+
+// IsExpired returns true if the transaction cannot be signed at the specified block height anymore.
+func (t Transaction) IsExpired(height int64) bool {
+	return t.ExpiresAt > 0 && height > t.ExpiresAt
+}
+
 // String implements fmt.Stringer interface.
 func (t *Transaction) String() string {
-	return fmt.Sprintf("Transaction %s: %s --> %s %+v", t.ID, t.Wallet, t.Receiver, t.Coins)
+	if t.Message != nil {
+		return fmt.Sprintf("Transaction %s (%s): %s --> %s/%s", t.ID, t.Status, t.Wallet, t.Message.Route(), t.Message.Type())
+	}
+	return fmt.Sprintf("Transaction %s (%s): %s --> %s %+v", t.ID, t.Status, t.Wallet, t.Receiver, t.Coins)
 }
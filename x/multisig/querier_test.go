@@ -0,0 +1,89 @@
+package multisig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"bitbucket.org/decimalteam/go-node/x/coin"
+	mul "bitbucket.org/decimalteam/go-node/x/multisig/internal/keeper"
+	"bitbucket.org/decimalteam/go-node/x/multisig/internal/types"
+)
+
+func TestQueryIndexedTransactions(t *testing.T) {
+	testQueryTransactions(t, mul.CreateTestInput)
+}
+
+// the same results are returned by iterating all wallets and transactions until the indexes are built
+func TestQueryLegacyTransactions(t *testing.T) {
+	testQueryTransactions(t, mul.CreateLegacyTestInput)
+}
+
+func testQueryTransactions(t *testing.T, createInput func(*testing.T, bool) (sdk.Context, mul.Keeper, coin.Keeper, auth.AccountKeeper, bank.Keeper)) {
+	ctx, keeper, _, accountKeeper, bankKeeper := createInput(t, false)
+	querier := NewQuerier(keeper)
+	cdc := mul.MakeTestCodec()
+
+	owners := []sdk.AccAddress{mul.Addrs[0], mul.Addrs[1], mul.Addrs[2]}
+	_, err := handleMsgCreateWallet(ctx, keeper, types.NewMsgCreateWallet(mul.Addrs[0], owners, []uint{1, 1, 1}, 2))
+	require.NoError(t, err)
+	_, err = handleMsgCreateWallet(ctx.WithTxBytes([]byte{1}), keeper, types.NewMsgCreateWallet(mul.Addrs[3], owners[1:], []uint{1, 1}, 2))
+	require.NoError(t, err)
+
+	wallet, err := types.NewWallet(owners, []uint{1, 1, 1}, 2, ctx.TxBytes())
+	require.NoError(t, err)
+	accountKeeper.NewAccountWithAddress(ctx, wallet.Address)
+	err = bankKeeper.SetCoins(ctx, wallet.Address, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000))))
+	require.NoError(t, err)
+
+	var txIDs []string
+	for i := int64(1); i <= 3; i++ {
+		ctx = ctx.WithBlockHeight(i)
+		coins := sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(i)))
+		_, err = handleMsgCreateTransaction(ctx, keeper, types.NewMsgCreateTransaction(mul.Addrs[0], wallet.Address, mul.Addrs[4], coins, 0))
+		require.NoError(t, err)
+		tx, err := types.NewTransaction(wallet.Address, mul.Addrs[4], coins, make([]sdk.AccAddress, len(owners)), i, ctx.TxBytes())
+		require.NoError(t, err)
+		txIDs = append(txIDs, tx.ID)
+	}
+	_, err = handleMsgSignTransaction(ctx, keeper, types.NewMsgSignTransaction(mul.Addrs[1], txIDs[1]), true)
+	require.NoError(t, err)
+
+	query := func(path string, params interface{}) []byte {
+		data := cdc.MustMarshalJSON(params)
+		res, err := querier(ctx, []string{path}, abci.RequestQuery{Data: data})
+		require.NoError(t, err)
+		return res
+	}
+
+	var wallets types.QueryWallets
+	cdc.MustUnmarshalJSON(query(mul.QueryListWallets, types.NewQueryWalletsParams(mul.Addrs[0], 0, 0)), &wallets)
+	require.Len(t, wallets, 1)
+	cdc.MustUnmarshalJSON(query(mul.QueryListWallets, types.NewQueryWalletsParams(mul.Addrs[1], 0, 0)), &wallets)
+	require.Len(t, wallets, 2)
+
+	var transactions types.QueryTransactions
+	cdc.MustUnmarshalJSON(query(mul.QueryListTransactions, types.NewQueryTransactionsParams(wallet.Address, "", 1, 2)), &transactions)
+	require.Len(t, transactions, 2)
+	require.Equal(t, txIDs[2], transactions[0].ID)
+	require.Equal(t, txIDs[1], transactions[1].ID)
+
+	cdc.MustUnmarshalJSON(query(mul.QueryListTransactions, types.NewQueryTransactionsParams(wallet.Address, types.TxStatusPending, 0, 0)), &transactions)
+	require.Len(t, transactions, 2)
+	cdc.MustUnmarshalJSON(query(mul.QueryListTransactions, types.NewQueryTransactionsParams(wallet.Address, types.TxStatusExecuted, 0, 0)), &transactions)
+	require.Len(t, transactions, 1)
+	require.Equal(t, txIDs[1], transactions[0].ID)
+
+	// transactions signed by the owner are not awaiting its signature
+	cdc.MustUnmarshalJSON(query(mul.QueryAwaitingTransactions, types.NewQueryWalletsParams(mul.Addrs[0], 0, 0)), &transactions)
+	require.Len(t, transactions, 0)
+	cdc.MustUnmarshalJSON(query(mul.QueryAwaitingTransactions, types.NewQueryWalletsParams(mul.Addrs[2], 0, 0)), &transactions)
+	require.Len(t, transactions, 2)
+	require.Equal(t, txIDs[2], transactions[0].ID)
+	require.Equal(t, txIDs[0], transactions[1].ID)
+}
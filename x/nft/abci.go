@@ -41,3 +41,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		}
 	}
 }
+
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.CloseEndedAuctions(ctx)
+}
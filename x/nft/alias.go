@@ -11,52 +11,115 @@ import (
 )
 
 const (
-	QuerySupply       = keeper.QuerySupply
-	QueryOwner        = keeper.QueryOwner
-	QueryOwnerByDenom = keeper.QueryOwnerByDenom
-	QueryCollection   = keeper.QueryCollection
-	QueryDenoms       = keeper.QueryDenoms
-	QueryNFT          = keeper.QueryNFT
-	ReservedPool      = types.ReservedPool
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	QuerierRoute      = types.QuerierRoute
-	RouterKey         = types.RouterKey
+	QuerySupply         = keeper.QuerySupply
+	QueryOwner          = keeper.QueryOwner
+	QueryOwnerByDenom   = keeper.QueryOwnerByDenom
+	QueryCollection     = keeper.QueryCollection
+	QueryDenoms         = keeper.QueryDenoms
+	QueryNFT            = keeper.QueryNFT
+	QueryCollectionInfo = keeper.QueryCollectionInfo
+	QueryListing        = keeper.QueryListing
+	QueryListings       = keeper.QueryListings
+	QueryApprovals      = keeper.QueryApprovals
+	QueryOperators      = keeper.QueryOperators
+	QueryCollectionNFTs = keeper.QueryCollectionNFTs
+	QueryHeldSubTokens  = keeper.QueryHeldSubTokens
+	QueryCreatedNFTs    = keeper.QueryCreatedNFTs
+	DefaultPageLimit    = types.DefaultPageLimit
+	MaxPageLimit        = types.MaxPageLimit
+	ReservedPool        = types.ReservedPool
+	MarketPool          = types.MarketPool
+	ModuleName          = types.ModuleName
+	StoreKey            = types.StoreKey
+	QuerierRoute        = types.QuerierRoute
+	RouterKey           = types.RouterKey
+
+	BasisPointsDenominator = types.BasisPointsDenominator
+	MaxRoyaltyBasisPoints  = types.MaxRoyaltyBasisPoints
+
+	ListingTypeFixedPrice  = types.ListingTypeFixedPrice
+	ListingTypeAuction     = types.ListingTypeAuction
+	ListingStatusActive    = types.ListingStatusActive
+	ListingStatusSold      = types.ListingStatusSold
+	ListingStatusCancelled = types.ListingStatusCancelled
+	ListingStatusExpired   = types.ListingStatusExpired
+	ListingStatusFailed    = types.ListingStatusFailed
 )
 
 var (
 	// functions aliases
-	RegisterInvariants      = keeper.RegisterInvariants
-	AllInvariants           = keeper.AllInvariants
-	SupplyInvariant         = keeper.SupplyInvariant
-	NewKeeper               = keeper.NewKeeper
-	NewQuerier              = keeper.NewQuerier
-	RegisterCodec           = types.RegisterCodec
-	NewCollection           = types.NewCollection
-	EmptyCollection         = types.EmptyCollection
-	NewCollections          = types.NewCollections
-	ErrInvalidCollection    = types.ErrInvalidCollection
-	ErrUnknownCollection    = types.ErrUnknownCollection
-	ErrInvalidNFT           = types.ErrInvalidNFT
-	ErrNFTAlreadyExists     = types.ErrNFTAlreadyExists
-	ErrUnknownNFT           = types.ErrUnknownNFT
-	ErrEmptyMetadata        = types.ErrEmptyMetadata
-	ErrNotAllowedBurn       = types.ErrNotAllowedBurn
-	ErrNotAllowedUpdateRes  = types.ErrNotAllowedUpdateReserve
-	ErrNotAllowedMint       = types.ErrNotAllowedMint
-	ErrNotUniqueSubTokenIDs = types.ErrNotUniqueSubTokenIDs
-	ErrNotUniqueTokenURI    = types.ErrNotUniqueTokenURI
-	ErrNotUniqueTokenID     = types.ErrNotUniqueTokenID
-	NewGenesisState         = types.NewGenesisState
-	DefaultGenesisState     = types.DefaultGenesisState
-	ValidateGenesis         = types.ValidateGenesis
-	NewBaseNFT              = types.NewBaseNFT
-	NewNFTs                 = types.NewNFTs
-	NewMsgMintNFT           = types.NewMsgMintNFT
-	NewMsgBurnNFT           = types.NewMsgBurnNFT
-	NewMsgUpdateReserveNFT  = types.NewMsgUpdateReserveNFT
-	NewMsgTranfserNFT       = types.NewMsgTransferNFT
-	NewMsgEditNFTMetadata   = types.NewMsgEditNFTMetadata
+	RegisterInvariants                = keeper.RegisterInvariants
+	AllInvariants                     = keeper.AllInvariants
+	SupplyInvariant                   = keeper.SupplyInvariant
+	ReserveInvariant                  = keeper.ReserveInvariant
+	NewKeeper                         = keeper.NewKeeper
+	NewQuerier                        = keeper.NewQuerier
+	RegisterCodec                     = types.RegisterCodec
+	NewCollection                     = types.NewCollection
+	EmptyCollection                   = types.EmptyCollection
+	NewCollections                    = types.NewCollections
+	ErrInvalidCollection              = types.ErrInvalidCollection
+	ErrUnknownCollection              = types.ErrUnknownCollection
+	ErrInvalidNFT                     = types.ErrInvalidNFT
+	ErrNFTAlreadyExists               = types.ErrNFTAlreadyExists
+	ErrUnknownNFT                     = types.ErrUnknownNFT
+	ErrEmptyMetadata                  = types.ErrEmptyMetadata
+	ErrNotAllowedBurn                 = types.ErrNotAllowedBurn
+	ErrNotAllowedUpdateRes            = types.ErrNotAllowedUpdateReserve
+	ErrNotAllowedMint                 = types.ErrNotAllowedMint
+	ErrNotUniqueSubTokenIDs           = types.ErrNotUniqueSubTokenIDs
+	ErrNotUniqueTokenURI              = types.ErrNotUniqueTokenURI
+	ErrNotUniqueTokenID               = types.ErrNotUniqueTokenID
+	ErrNotCollectionOwner             = types.ErrNotCollectionOwner
+	ErrNotAllowedCollectionMint       = types.ErrNotAllowedCollectionMint
+	ErrInvalidRoyalty                 = types.ErrInvalidRoyalty
+	ErrRoyaltyImmutable               = types.ErrRoyaltyImmutable
+	ErrInvalidTransferLock            = types.ErrInvalidTransferLock
+	ErrTransferRestrictionsImmutable  = types.ErrTransferRestrictionsImmutable
+	ErrNFTNotTransferable             = types.ErrNFTNotTransferable
+	ErrUnknownListing                 = types.ErrUnknownListing
+	ErrListingNotActive               = types.ErrListingNotActive
+	ErrNotListingSeller               = types.ErrNotListingSeller
+	ErrInvalidListingType             = types.ErrInvalidListingType
+	ErrInvalidPrice                   = types.ErrInvalidPrice
+	ErrInvalidEndHeight               = types.ErrInvalidEndHeight
+	ErrBidTooLow                      = types.ErrBidTooLow
+	ErrAuctionHasBids                 = types.ErrAuctionHasBids
+	ErrAuctionEnded                   = types.ErrAuctionEnded
+	ErrSellerCannotBuy                = types.ErrSellerCannotBuy
+	ErrNotApproved                    = types.ErrNotApproved
+	ErrInvalidOperator                = types.ErrInvalidOperator
+	ErrInvalidCursor                  = types.ErrInvalidCursor
+	NewGenesisState                   = types.NewGenesisState
+	DefaultGenesisState               = types.DefaultGenesisState
+	ValidateGenesis                   = types.ValidateGenesis
+	NewBaseNFT                        = types.NewBaseNFT
+	NewNFTs                           = types.NewNFTs
+	CalculateRoyalty                  = types.CalculateRoyalty
+	CheckTransferable                 = types.CheckTransferable
+	NewMsgMintNFT                     = types.NewMsgMintNFT
+	NewMsgBurnNFT                     = types.NewMsgBurnNFT
+	NewMsgUpdateReserveNFT            = types.NewMsgUpdateReserveNFT
+	NewMsgTranfserNFT                 = types.NewMsgTransferNFT
+	NewMsgEditNFTMetadata             = types.NewMsgEditNFTMetadata
+	NewMsgTransferCollectionOwnership = types.NewMsgTransferCollectionOwnership
+	NewMsgEditCollectionMetadata      = types.NewMsgEditCollectionMetadata
+	NewMsgSetCollectionMinter         = types.NewMsgSetCollectionMinter
+	NewCollectionInfo                 = types.NewCollectionInfo
+	NewMsgListNFT                     = types.NewMsgListNFT
+	NewMsgCreateAuction               = types.NewMsgCreateAuction
+	NewMsgCancelListing               = types.NewMsgCancelListing
+	NewMsgBuyNFT                      = types.NewMsgBuyNFT
+	NewMsgBidNFT                      = types.NewMsgBidNFT
+	NewFixedPriceListing              = types.NewFixedPriceListing
+	NewAuction                        = types.NewAuction
+	NewQueryListingParams             = types.NewQueryListingParams
+	NewQueryListingsParams            = types.NewQueryListingsParams
+	NewQueryPageParams                = types.NewQueryPageParams
+	NewMsgApproveNFT                  = types.NewMsgApproveNFT
+	NewMsgRevokeNFT                   = types.NewMsgRevokeNFT
+	NewSubTokenApproval               = types.NewSubTokenApproval
+	NewOperatorApproval               = types.NewOperatorApproval
 
 	CheckUnique = types.CheckUnique
 
@@ -65,25 +128,49 @@ var (
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Collection            = types.Collection
-	Collections           = types.Collections
-	CollectionJSON        = types.CollectionJSON
-	GenesisState          = types.GenesisState
-	MsgTransferNFT        = types.MsgTransferNFT
-	MsgEditNFTMetadata    = types.MsgEditNFTMetadata
-	MsgMintNFT            = types.MsgMintNFT
-	MsgBurnNFT            = types.MsgBurnNFT
-	MsgUpdateReserveNFT   = types.MsgUpdateReserveNFT
-	BaseNFT               = types.BaseNFT
-	NFTs                  = types.NFTs
-	NFTJSON               = types.NFTJSON
-	IDCollection          = types.IDCollection
-	IDCollections         = types.IDCollections
-	Owner                 = types.Owner
-	TokenOwner            = types.TokenOwner
-	QueryCollectionParams = types.QueryCollectionParams
-	QueryBalanceParams    = types.QueryBalanceParams
-	QueryNFTParams        = types.QueryNFTParams
-	SortedIntArray        = types.SortedIntArray
+	Keeper                         = keeper.Keeper
+	Collection                     = types.Collection
+	Collections                    = types.Collections
+	CollectionJSON                 = types.CollectionJSON
+	GenesisState                   = types.GenesisState
+	MsgTransferNFT                 = types.MsgTransferNFT
+	MsgEditNFTMetadata             = types.MsgEditNFTMetadata
+	MsgMintNFT                     = types.MsgMintNFT
+	MsgBurnNFT                     = types.MsgBurnNFT
+	MsgUpdateReserveNFT            = types.MsgUpdateReserveNFT
+	MsgTransferCollectionOwnership = types.MsgTransferCollectionOwnership
+	MsgEditCollectionMetadata      = types.MsgEditCollectionMetadata
+	MsgSetCollectionMinter         = types.MsgSetCollectionMinter
+	CollectionInfo                 = types.CollectionInfo
+	MsgListNFT                     = types.MsgListNFT
+	MsgCreateAuction               = types.MsgCreateAuction
+	MsgCancelListing               = types.MsgCancelListing
+	MsgBuyNFT                      = types.MsgBuyNFT
+	MsgBidNFT                      = types.MsgBidNFT
+	Listing                        = types.Listing
+	Listings                       = types.Listings
+	QueryListingParams             = types.QueryListingParams
+	QueryListingsParams            = types.QueryListingsParams
+	QueryPageParams                = types.QueryPageParams
+	ResponseNFT                    = types.ResponseNFT
+	ResponseNFTsPage               = types.ResponseNFTsPage
+	HeldSubTokens                  = types.HeldSubTokens
+	ResponseHeldSubTokensPage      = types.ResponseHeldSubTokensPage
+	MsgApproveNFT                  = types.MsgApproveNFT
+	MsgRevokeNFT                   = types.MsgRevokeNFT
+	SubTokenApproval               = types.SubTokenApproval
+	SubTokenApprovals              = types.SubTokenApprovals
+	OperatorApproval               = types.OperatorApproval
+	OperatorApprovals              = types.OperatorApprovals
+	BaseNFT                        = types.BaseNFT
+	NFTs                           = types.NFTs
+	NFTJSON                        = types.NFTJSON
+	IDCollection                   = types.IDCollection
+	IDCollections                  = types.IDCollections
+	Owner                          = types.Owner
+	TokenOwner                     = types.TokenOwner
+	QueryCollectionParams          = types.QueryCollectionParams
+	QueryBalanceParams             = types.QueryBalanceParams
+	QueryNFTParams                 = types.QueryNFTParams
+	SortedIntArray                 = types.SortedIntArray
 )
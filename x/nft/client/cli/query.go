@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -33,6 +34,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryDenoms(queryRoute, cdc),
 		GetCmdQueryNFT(queryRoute, cdc),
 		GetCmdQuerySubTokens(queryRoute, cdc),
+		GetCmdQueryCollectionInfo(queryRoute, cdc),
+		GetCmdQueryListing(queryRoute, cdc),
+		GetCmdQueryListings(queryRoute, cdc),
+		GetCmdQueryApprovals(queryRoute, cdc),
+		GetCmdQueryOperators(queryRoute, cdc),
+		GetCmdQueryCollectionNFTs(queryRoute, cdc),
+		GetCmdQueryHeldSubTokens(queryRoute, cdc),
+		GetCmdQueryCreatedNFTs(queryRoute, cdc),
 	)...)
 
 	return nftQueryCmd
@@ -289,3 +298,367 @@ func GetCmdQuerySubTokens(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryCollectionInfo queries the owner, allowed minters and metadata of a collection
+func GetCmdQueryCollectionInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "collection-info [denom]",
+		Short: "get the owner, allowed minters and metadata of a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the owner, allowed minters and metadata of a given collection.
+
+Example:
+$ %s query %s collection-info crypto-kitties
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom := args[0]
+
+			params := types.NewQueryCollectionParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/collection_info", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.CollectionInfo
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryListing queries a marketplace listing or auction by its ID
+func GetCmdQueryListing(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "listing [id]",
+		Short: "get a marketplace listing or auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a fixed price listing or an auction of NFT sub-tokens by its ID.
+
+Example:
+$ %s query %s listing 1
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid listing ID")
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryListingParams(id))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listing", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Listing
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryListings queries marketplace listings and auctions filtered by denom, seller and status
+func GetCmdQueryListings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings",
+		Short: "get marketplace listings and auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get fixed price listings and auctions of NFT sub-tokens.
+Listings can be filtered by collection, seller and status (active, sold, cancelled, expired or failed).
+
+Example:
+$ %s query %s listings --denom crypto-kitties --status active
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var seller sdk.AccAddress
+			if sellerStr := viper.GetString(flagSeller); sellerStr != "" {
+				var err error
+				seller, err = sdk.AccAddressFromBech32(sellerStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryListingsParams(viper.GetString(flagDenom), seller, viper.GetString(flagStatus))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listings", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Listings
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "Filter listings by collection")
+	cmd.Flags().String(flagSeller, "", "Filter listings by seller address")
+	cmd.Flags().String(flagStatus, "", "Filter listings by status")
+	return cmd
+}
+
+// GetCmdQueryApprovals queries the approvals of the sub-tokens of an NFT
+func GetCmdQueryApprovals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approvals [denom] [ID]",
+		Short: "get operators approved to transfer sub-tokens of an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get operators approved to transfer single sub-tokens of an NFT.
+
+Example:
+$ %s query %s approvals crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNFTParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approvals", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.SubTokenApprovals
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryOperators queries the operators approved by an owner for a collection
+func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operators [owner] [denom]",
+		Short: "get operators approved to transfer all the sub-tokens of a collection held by an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get operators approved to transfer all the sub-tokens of a collection held by an owner.
+
+Example:
+$ %s query %s operators dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p crypto-kitties
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryBalanceParams(owner, args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operators", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.OperatorApprovals
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryCollectionNFTs queries a page of NFTs of a collection
+func GetCmdQueryCollectionNFTs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection-nfts [denom]",
+		Short: "get a page of NFTs of a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a page of NFTs of a collection.
+The next page is queried with the next cursor returned with the previous page.
+
+Example:
+$ %s query %s collection-nfts crypto-kitties --limit 50 --cursor 5c4a
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryPageParams(args[0], nil, viper.GetString(flagCursor), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/collection_nfts", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ResponseNFTsPage
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryHeldSubTokens queries a page of sub-tokens held by an address across all collections
+func GetCmdQueryHeldSubTokens(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-sub-tokens [owner]",
+		Short: "get a page of sub-tokens held by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a page of sub-tokens held by an address across all collections.
+The next page is queried with the next cursor returned with the previous page.
+
+Example:
+$ %s query %s held-sub-tokens dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --limit 50
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryPageParams("", owner, viper.GetString(flagCursor), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/held_sub_tokens", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ResponseHeldSubTokensPage
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryCreatedNFTs queries a page of NFTs created by an address across all collections
+func GetCmdQueryCreatedNFTs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "created-nfts [creator]",
+		Short: "get a page of NFTs created by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a page of NFTs created by an address across all collections.
+The next page is queried with the next cursor returned with the previous page.
+
+Example:
+$ %s query %s created-nfts dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --limit 50
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryPageParams("", creator, viper.GetString(flagCursor), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/created_nfts", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ResponseNFTsPage
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagCursor, "", "Cursor of the page returned as the next cursor with the previous page")
+	cmd.Flags().Int(flagLimit, types.DefaultPageLimit, fmt.Sprintf("Number of records of the page, at most %d", types.MaxPageLimit))
+}
@@ -26,6 +26,44 @@ const (
 	flagTokenURI = "tokenURI"
 )
 
+// Royalty flags
+const (
+	flagRoyaltyRecipient = "royalty-recipient"
+	flagRoyaltyBPS       = "royalty-bps"
+	flagPrice            = "price"
+)
+
+// Transfer restriction flags
+const (
+	flagTransferable        = "transferable"
+	flagTransferLockedUntil = "transfer-locked-until"
+)
+
+// Approval flags
+const (
+	flagOwner = "owner"
+)
+
+// Marketplace flags
+const (
+	flagDenom  = "denom"
+	flagSeller = "seller"
+	flagStatus = "status"
+)
+
+// Pagination flags
+const (
+	flagCursor = "cursor"
+	flagLimit  = "limit"
+)
+
+// Edit collection metadata flags
+const (
+	flagName        = "name"
+	flagDescription = "description"
+	flagURI         = "uri"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -40,6 +78,16 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdMintNFT(cdc),
 		GetCmdBurnNFT(cdc),
 		GetCmdUpdateReserveNFT(cdc),
+		GetCmdTransferCollectionOwnership(cdc),
+		GetCmdEditCollectionMetadata(cdc),
+		GetCmdSetCollectionMinter(cdc),
+		GetCmdListNFT(cdc),
+		GetCmdCreateAuction(cdc),
+		GetCmdCancelListing(cdc),
+		GetCmdBuyNFT(cdc),
+		GetCmdBidNFT(cdc),
+		GetCmdApproveNFT(cdc),
+		GetCmdRevokeNFT(cdc),
 	)...)
 
 	return nftTxCmd
@@ -47,7 +95,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdTransferNFT is the CLI command for sending a TransferNFT transaction
 func GetCmdTransferNFT(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "transfer [sender] [recipient] [denom] [tokenID] [sub_token_ids]",
 		Short: "transfer a NFT to a recipient",
 		Long: strings.TrimSpace(
@@ -57,8 +105,11 @@ func GetCmdTransferNFT(cdc *codec.Codec) *cobra.Command {
 Example:
 $ %s tx %s transfer 
 dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm \
-crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
---from mykey
+crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa 1,2 \
+--price 100del --from mykey
+
+The optional price declares the sale price of the sub-tokens, the NFT royalty is paid from it by the sender.
+An approved operator transfers sub-tokens of their owner set by the --owner flag.
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -93,9 +144,27 @@ crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
 			}
 
 			msg := types.NewMsgTransferNFT(sender, recipient, denom, tokenID, subTokenIDs)
+			if priceStr := viper.GetString(flagPrice); priceStr != "" {
+				price, err := sdk.ParseCoin(priceStr)
+				if err != nil {
+					return err
+				}
+				msg = msg.WithPrice(price)
+			}
+			if ownerStr := viper.GetString(flagOwner); ownerStr != "" {
+				owner, err := sdk.AccAddressFromBech32(ownerStr)
+				if err != nil {
+					return err
+				}
+				msg = msg.WithOwner(owner)
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagPrice, "", "Declared sale price of the sub-tokens to pay the royalty from")
+	cmd.Flags().String(flagOwner, "", "Owner of the sub-tokens transferred by the approved sender")
+	return cmd
 }
 
 // GetCmdEditNFTMetadata is the CLI command for sending an EditMetadata transaction
@@ -144,7 +213,13 @@ func GetCmdMintNFT(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ %s tx %s mint crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
-dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1 1000000000000000000 t \
+--royalty-recipient dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --royalty-bps 500 --from mykey
+
+The royalty (in basis points of the sale price) can be set only by the first mint of the NFT.
+Transfer restrictions can be set only by the first mint of the NFT as well: holders can not transfer
+or delegate sub-tokens minted with --transferable=false at all, or before the --transfer-locked-until height.
+The creator can still take them back with the transfer command and the --owner flag.
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -181,11 +256,23 @@ dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 			}
 
 			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, tokenID, denom, tokenURI, quantity, reserve, allowMint)
+			if royaltyRecipientStr := viper.GetString(flagRoyaltyRecipient); royaltyRecipientStr != "" {
+				royaltyRecipient, err := sdk.AccAddressFromBech32(royaltyRecipientStr)
+				if err != nil {
+					return err
+				}
+				msg = msg.WithRoyalty(royaltyRecipient, viper.GetUint64(flagRoyaltyBPS))
+			}
+			msg = msg.WithTransferRestrictions(viper.GetBool(flagTransferable), viper.GetInt64(flagTransferLockedUntil))
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagTokenURI, "", "URI for supplemental off-chain metadata (should return a JSON object)")
+	cmd.Flags().String(flagRoyaltyRecipient, "", "Recipient of the royalty paid on sales")
+	cmd.Flags().Uint64(flagRoyaltyBPS, 0, "Royalty in basis points of the sale price (1 bps = 0.01%)")
+	cmd.Flags().Bool(flagTransferable, true, "Whether holders can transfer sub-tokens of the NFT")
+	cmd.Flags().Int64(flagTransferLockedUntil, 0, "Height before which holders can not transfer sub-tokens of the NFT")
 
 	return cmd
 }
@@ -193,14 +280,15 @@ dx1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 // GetCmdBurnNFT is the CLI command for sending a BurnNFT transaction
 func GetCmdBurnNFT(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "burn [denom] [tokenID] [quantity]",
-		Short: "burn an NFT",
+		Use:   "burn [denom] [tokenID] [sub_token_ids]",
+		Short: "burn sub-tokens of an NFT",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Burn (i.e permanently delete) an NFT from a given collection that has a 
-			specific id (SHA-256 hex hash).
+			fmt.Sprintf(`Burn (i.e permanently delete) sub-tokens held by the sender of an NFT from 
+			a given collection that has a specific id (SHA-256 hex hash). The reserve of burnt 
+			sub-tokens is returned to the sender.
 
 Example:
-$ %s tx %s burn crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+$ %s tx %s burn crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa 1,2 \
 --from mykey
 `,
 				version.ClientName, types.ModuleName,
@@ -274,3 +362,389 @@ $ %s tx %s update-reserv crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd42
 		},
 	}
 }
+
+// GetCmdTransferCollectionOwnership is the CLI command for sending a TransferCollectionOwnership transaction
+func GetCmdTransferCollectionOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-collection [denom] [new_owner]",
+		Short: "transfer ownership of a collection to another address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer ownership of a collection. Only the owner of a collection 
+			can mint into it, allow other addresses to mint and edit collection metadata.
+
+Example:
+$ %s tx %s transfer-collection crypto-kitties dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			denom := args[0]
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferCollectionOwnership(cliCtx.GetFromAddress(), denom, newOwner)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdEditCollectionMetadata is the CLI command for sending an EditCollectionMetadata transaction
+func GetCmdEditCollectionMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-collection [denom]",
+		Short: "edit the metadata of a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the name, description and URI of a collection.
+
+Example:
+$ %s tx %s edit-collection crypto-kitties --name "Crypto Kitties" \
+--description "Collectible cats" --uri https://example.com/kitties.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			denom := args[0]
+			name := viper.GetString(flagName)
+			description := viper.GetString(flagDescription)
+			uri := viper.GetString(flagURI)
+
+			msg := types.NewMsgEditCollectionMetadata(cliCtx.GetFromAddress(), denom, name, description, uri)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Name of the collection")
+	cmd.Flags().String(flagDescription, "", "Description of the collection")
+	cmd.Flags().String(flagURI, "", "URI for supplemental off-chain metadata of the collection")
+	return cmd
+}
+
+// GetCmdSetCollectionMinter is the CLI command for sending a SetCollectionMinter transaction
+func GetCmdSetCollectionMinter(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-minter [denom] [minter] [allowed]",
+		Short: "allow or disallow an address to mint into a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add an address to the allow-list of a collection or remove it from there.
+
+Example:
+$ %s tx %s set-minter crypto-kitties dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm true --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			denom := args[0]
+
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowed, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCollectionMinter(cliCtx.GetFromAddress(), denom, minter, allowed)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdListNFT is the CLI command for listing NFT sub-tokens for sale at a fixed price
+func GetCmdListNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [denom] [tokenID] [sub_token_ids] [price]",
+		Short: "list NFT sub-tokens for sale at a fixed price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List NFT sub-tokens for sale at a fixed price in any coin.
+The sub-tokens are held by the marketplace until they are bought or the listing is cancelled.
+
+Example:
+$ %s tx %s list crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa 1,2 100del \
+--from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subTokenIDs, err := parseSubTokenIDs(args[2])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgListNFT(cliCtx.GetFromAddress(), args[0], args[1], subTokenIDs, price)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCreateAuction is the CLI command for selling NFT sub-tokens by an English auction
+func GetCmdCreateAuction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auction [denom] [tokenID] [sub_token_ids] [reserve_price] [end_height]",
+		Short: "sell NFT sub-tokens by an English auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell NFT sub-tokens by an English auction with the reserve price.
+The auction is settled with the highest bid at the end height.
+
+Example:
+$ %s tx %s auction crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa 1,2 100del 8000000 \
+--from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subTokenIDs, err := parseSubTokenIDs(args[2])
+			if err != nil {
+				return err
+			}
+
+			reservePrice, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height")
+			}
+
+			msg := types.NewMsgCreateAuction(cliCtx.GetFromAddress(), args[0], args[1], subTokenIDs, reservePrice, endHeight)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelListing is the CLI command for cancelling a listing or an auction without bids
+func GetCmdCancelListing(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-listing [listing_id]",
+		Short: "cancel a listing or an auction without bids",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a listing or an auction without bids and return the sub-tokens to the seller.
+
+Example:
+$ %s tx %s cancel-listing 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid listing ID")
+			}
+
+			msg := types.NewMsgCancelListing(cliCtx.GetFromAddress(), listingID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBuyNFT is the CLI command for buying listed NFT sub-tokens
+func GetCmdBuyNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "buy [listing_id]",
+		Short: "buy NFT sub-tokens listed at a fixed price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy NFT sub-tokens listed at a fixed price. The price is paid to the seller
+and the NFT royalty is paid from it.
+
+Example:
+$ %s tx %s buy 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid listing ID")
+			}
+
+			msg := types.NewMsgBuyNFT(cliCtx.GetFromAddress(), listingID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBidNFT is the CLI command for bidding on an NFT auction
+func GetCmdBidNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid [listing_id] [amount]",
+		Short: "bid on an NFT auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bid on an NFT auction. The bid is held by the marketplace and returned
+when a higher bid is placed.
+
+Example:
+$ %s tx %s bid 1 150del --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid listing ID")
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBidNFT(cliCtx.GetFromAddress(), listingID, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdApproveNFT is the CLI command for approving an operator to transfer NFT sub-tokens
+func GetCmdApproveNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve [operator] [denom] [tokenID] [sub_token_ids]",
+		Short: "approve an operator to transfer NFT sub-tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Approve an operator to transfer the listed NFT sub-tokens of the sender.
+Without the token ID and sub-token IDs the operator is approved to transfer all the sub-tokens
+of the collection held by the sender. Approvals of sub-tokens are cleared when they change the owner.
+
+Example:
+$ %s tx %s approve dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm crypto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa 1,2 --from mykey
+$ %s tx %s approve dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm crypto-kitties --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, id, subTokenIDs, err := parseApprovalArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveNFT(cliCtx.GetFromAddress(), operator, args[1], id, subTokenIDs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeNFT is the CLI command for revoking an approval of an operator
+func GetCmdRevokeNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [operator] [denom] [tokenID] [sub_token_ids]",
+		Short: "revoke an approval of an operator to transfer NFT sub-tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an approval given by the approve command with the same arguments.
+
+Example:
+$ %s tx %s revoke dx1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm crypto-kitties --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, id, subTokenIDs, err := parseApprovalArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeNFT(cliCtx.GetFromAddress(), operator, args[1], id, subTokenIDs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseApprovalArgs(args []string) (operator sdk.AccAddress, id string, subTokenIDs []int64, err error) {
+	operator, err = sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return
+	}
+	switch len(args) {
+	case 2:
+		return
+	case 4:
+		id = args[2]
+		subTokenIDs, err = parseSubTokenIDs(args[3])
+		return
+	default:
+		err = fmt.Errorf("sub-token IDs are required with the token ID")
+		return
+	}
+}
+
+func parseSubTokenIDs(arg string) ([]int64, error) {
+	subTokenIDsStr := strings.Split(arg, ",")
+	subTokenIDs := make([]int64, len(subTokenIDsStr))
+	for i, d := range subTokenIDsStr {
+		subTokenID, err := strconv.ParseInt(d, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subTokenID")
+		}
+		subTokenIDs[i] = subTokenID
+	}
+	return subTokenIDs, nil
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -35,6 +36,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/nft/collection/{denom}", getCollection(cdc, cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the owner, allowed minters and metadata of a collection
+	r.HandleFunc(
+		"/nft/collection/{denom}/info", getCollectionInfo(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Query all denoms
 	r.HandleFunc(
 		"/nft/denoms", getDenoms(cdc, cliCtx, queryRoute),
@@ -44,6 +50,41 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(
 		"/nft/collection/{denom}/nft/{id}", getNFT(cdc, cliCtx, queryRoute),
 	).Methods("GET")
+
+	// Query operators approved to transfer single sub-tokens of an NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/approvals", getApprovals(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query operators approved by an address for a collection
+	r.HandleFunc(
+		"/nft/owner/{delegatorAddr}/collection/{denom}/operators", getOperators(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query marketplace listings filtered by denom, seller and status
+	r.HandleFunc(
+		"/nft/market", getListings(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a page of NFTs of a collection
+	r.HandleFunc(
+		"/nft/collection/{denom}/nfts", getCollectionNFTs(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a page of sub-tokens held by an address across all collections
+	r.HandleFunc(
+		"/nft/owner/{delegatorAddr}/sub_tokens", getHeldSubTokens(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a page of NFTs created by an address across all collections
+	r.HandleFunc(
+		"/nft/creator/{creator}/nfts", getCreatedNFTs(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a single marketplace listing
+	r.HandleFunc(
+		"/nft/market/{listingID}", getListing(cdc, cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func getSupply(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -140,6 +181,27 @@ func getCollection(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute strin
 	}
 }
 
+func getCollectionInfo(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		params := types.NewQueryCollectionParams(denom)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/collection_info", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func getDenoms(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denoms", queryRoute), nil)
@@ -174,3 +236,164 @@ func getNFT(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func getListing(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["listingID"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid listing ID")
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(types.NewQueryListingParams(id))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listing", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getListings(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var seller sdk.AccAddress
+		if sellerStr := query.Get("seller"); sellerStr != "" {
+			var err error
+			seller, err = sdk.AccAddressFromBech32(sellerStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryListingsParams(query.Get("denom"), seller, query.Get("status"))
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/listings", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getApprovals(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := types.NewQueryNFTParams(vars["denom"], vars["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approvals", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getOperators(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		address, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryBalanceParams(address, vars["denom"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operators", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryPage queries a page with the cursor and limit taken from the request query string
+func queryPage(w http.ResponseWriter, r *http.Request, cdc *codec.Codec, cliCtx context.CLIContext, route, denom string, address sdk.AccAddress) {
+	query := r.URL.Query()
+
+	limit := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	params := types.NewQueryPageParams(denom, address, query.Get("cursor"), limit)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
+func getCollectionNFTs(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+		queryPage(w, r, cdc, cliCtx, fmt.Sprintf("custom/%s/collection_nfts", queryRoute), denom, nil)
+	}
+}
+
+func getHeldSubTokens(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		queryPage(w, r, cdc, cliCtx, fmt.Sprintf("custom/%s/held_sub_tokens", queryRoute), "", owner)
+	}
+}
+
+func getCreatedNFTs(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		creator, err := sdk.AccAddressFromBech32(mux.Vars(r)["creator"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		queryPage(w, r, cdc, cliCtx, fmt.Sprintf("custom/%s/created_nfts", queryRoute), "", creator)
+	}
+}
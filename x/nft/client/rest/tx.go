@@ -45,6 +45,66 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router,
 		"/nfts/collection/{denom}/nft/{id}/updateReserve",
 		updateReserveNFTHandler(cdc, cliCtx),
 	).Methods("PUT")
+
+	// Transfer ownership of a collection
+	r.HandleFunc(
+		"/nfts/collection/{denom}/owner",
+		transferCollectionOwnershipHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Update a collection metadata
+	r.HandleFunc(
+		"/nfts/collection/{denom}/metadata",
+		editCollectionMetadataHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Allow or disallow an address to mint into a collection
+	r.HandleFunc(
+		"/nfts/collection/{denom}/minters",
+		setCollectionMinterHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// List NFT sub-tokens for sale at a fixed price
+	r.HandleFunc(
+		"/nfts/market/list",
+		listNFTHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Sell NFT sub-tokens by an auction
+	r.HandleFunc(
+		"/nfts/market/auction",
+		createAuctionHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Cancel a listing or an auction without bids
+	r.HandleFunc(
+		"/nfts/market/{listingID}/cancel",
+		cancelListingHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Buy listed NFT sub-tokens
+	r.HandleFunc(
+		"/nfts/market/{listingID}/buy",
+		buyNFTHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Bid on an auction
+	r.HandleFunc(
+		"/nfts/market/{listingID}/bid",
+		bidNFTHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Approve an operator to transfer NFT sub-tokens
+	r.HandleFunc(
+		"/nfts/approve",
+		approveNFTHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Revoke an approval of an operator
+	r.HandleFunc(
+		"/nfts/revoke",
+		revokeNFTHandler(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type transferNFTReq struct {
@@ -53,6 +113,8 @@ type transferNFTReq struct {
 	ID          string       `json:"id"`
 	Recipient   string       `json:"recipient"`
 	SubTokenIDs []string     `json:"subTokenIDs"`
+	Price       string       `json:"price,omitempty"`
+	Owner       string       `json:"owner,omitempty"`
 }
 
 func transferNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -91,6 +153,22 @@ func transferNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 
 		// create the message
 		msg := types.NewMsgTransferNFT(fromAddr, recipient, req.Denom, req.ID, subTokenIDs)
+		if req.Price != "" {
+			price, err := sdk.ParseCoin(req.Price)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = msg.WithPrice(price)
+		}
+		if req.Owner != "" {
+			owner, err := sdk.AccAddressFromBech32(req.Owner)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = msg.WithOwner(owner)
+		}
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
@@ -135,6 +213,12 @@ type mintNFTReq struct {
 	ID        string         `json:"id"`
 	TokenURI  string         `json:"tokenURI"`
 	Quantity  string         `json:"quantity"`
+
+	RoyaltyRecipient   sdk.AccAddress `json:"royalty_recipient,omitempty"`
+	RoyaltyBasisPoints uint64         `json:"royalty_basis_points,omitempty"`
+
+	NonTransferable     bool  `json:"non_transferable,omitempty"`
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty"`
 }
 
 func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -163,6 +247,10 @@ func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFun
 
 		// create the message
 		msg := types.NewMsgMintNFT(fromAddr, req.Recipient, req.ID, req.Denom, req.TokenURI, quantity, sdk.NewInt(1), false)
+		if !req.RoyaltyRecipient.Empty() {
+			msg = msg.WithRoyalty(req.RoyaltyRecipient, req.RoyaltyBasisPoints)
+		}
+		msg = msg.WithTransferRestrictions(!req.NonTransferable, req.TransferLockedUntil)
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
@@ -255,3 +343,328 @@ func updateReserveNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.H
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+type transferCollectionOwnershipReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	NewOwner string       `json:"new_owner"`
+}
+
+func transferCollectionOwnershipHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferCollectionOwnershipReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgTransferCollectionOwnership(fromAddr, mux.Vars(r)["denom"], newOwner)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type editCollectionMetadataReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	URI         string       `json:"uri"`
+}
+
+func editCollectionMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req editCollectionMetadataReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgEditCollectionMetadata(fromAddr, mux.Vars(r)["denom"], req.Name, req.Description, req.URI)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setCollectionMinterReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Minter  string       `json:"minter"`
+	Allowed bool         `json:"allowed"`
+}
+
+func setCollectionMinterHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setCollectionMinterReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		minter, err := sdk.AccAddressFromBech32(req.Minter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetCollectionMinter(fromAddr, mux.Vars(r)["denom"], minter, req.Allowed)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type listNFTReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Denom       string       `json:"denom"`
+	ID          string       `json:"id"`
+	SubTokenIDs []string     `json:"subTokenIDs"`
+	Price       string       `json:"price"`
+	EndHeight   string       `json:"end_height,omitempty"`
+}
+
+func listNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, subTokenIDs, price, ok := parseListNFTReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgListNFT(fromAddr, req.Denom, req.ID, subTokenIDs, price)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func createAuctionHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, subTokenIDs, reservePrice, ok := parseListNFTReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		endHeight, err := strconv.ParseInt(req.EndHeight, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid end height")
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateAuction(fromAddr, req.Denom, req.ID, subTokenIDs, reservePrice, endHeight)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func parseListNFTReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec) (listNFTReq, sdk.AccAddress, []int64, sdk.Coin, bool) {
+	var req listNFTReq
+	if !rest.ReadRESTReq(w, r, cdc, &req) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		return req, nil, nil, sdk.Coin{}, false
+	}
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return req, nil, nil, sdk.Coin{}, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, sdk.Coin{}, false
+	}
+
+	subTokenIDs := make([]int64, len(req.SubTokenIDs))
+	for i, d := range req.SubTokenIDs {
+		subTokenID, err := strconv.ParseInt(d, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid subTokenID")
+			return req, nil, nil, sdk.Coin{}, false
+		}
+		subTokenIDs[i] = subTokenID
+	}
+
+	price, err := sdk.ParseCoin(req.Price)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, sdk.Coin{}, false
+	}
+
+	return req, fromAddr, subTokenIDs, price, true
+}
+
+type listingReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  string       `json:"amount,omitempty"`
+}
+
+func cancelListingHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, listingID, ok := parseListingReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelListing(fromAddr, listingID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func buyNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, listingID, ok := parseListingReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgBuyNFT(fromAddr, listingID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func bidNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, listingID, ok := parseListingReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		amount, err := sdk.ParseCoin(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgBidNFT(fromAddr, listingID, amount)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func parseListingReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec) (listingReq, sdk.AccAddress, uint64, bool) {
+	var req listingReq
+	if !rest.ReadRESTReq(w, r, cdc, &req) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		return req, nil, 0, false
+	}
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return req, nil, 0, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, 0, false
+	}
+
+	listingID, err := strconv.ParseUint(mux.Vars(r)["listingID"], 10, 64)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid listing ID")
+		return req, nil, 0, false
+	}
+
+	return req, fromAddr, listingID, true
+}
+
+type approveNFTReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Operator    string       `json:"operator"`
+	Denom       string       `json:"denom"`
+	ID          string       `json:"id,omitempty"`
+	SubTokenIDs []string     `json:"subTokenIDs,omitempty"`
+}
+
+func approveNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, operator, subTokenIDs, ok := parseApproveNFTReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgApproveNFT(fromAddr, operator, req.Denom, req.ID, subTokenIDs)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, fromAddr, operator, subTokenIDs, ok := parseApproveNFTReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeNFT(fromAddr, operator, req.Denom, req.ID, subTokenIDs)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func parseApproveNFTReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec) (approveNFTReq, sdk.AccAddress, sdk.AccAddress, []int64, bool) {
+	var req approveNFTReq
+	if !rest.ReadRESTReq(w, r, cdc, &req) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		return req, nil, nil, nil, false
+	}
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return req, nil, nil, nil, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, nil, false
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, nil, nil, nil, false
+	}
+
+	var subTokenIDs []int64
+	for _, d := range req.SubTokenIDs {
+		subTokenID, err := strconv.ParseInt(d, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid subTokenID")
+			return req, nil, nil, nil, false
+		}
+		subTokenIDs = append(subTokenIDs, subTokenID)
+	}
+
+	return req, fromAddr, operator, subTokenIDs, true
+}
@@ -14,6 +14,13 @@ type NFT interface {
 	EditMetadata(tokenURI string) NFT
 	GetReserve() sdk.Int
 	GetAllowMint() bool
+	GetRoyaltyRecipient() sdk.AccAddress
+	GetRoyaltyBasisPoints() uint64
+	SetRoyalty(recipient sdk.AccAddress, basisPoints uint64) NFT
+	IsNonTransferable() bool
+	GetTransferLockedUntil() int64
+	IsTransferable(height int64) bool
+	SetTransferRestrictions(nonTransferable bool, lockedUntil int64) NFT
 	String() string
 }
 
@@ -29,6 +36,7 @@ type TokenOwner interface {
 type TokenOwners interface {
 	GetOwners() []TokenOwner
 	SetOwner(owner TokenOwner) TokenOwners
+	RemoveOwner(owner sdk.AccAddress) TokenOwners
 	GetOwner(owner sdk.AccAddress) TokenOwner
 	String() string
 }
@@ -1,17 +1,63 @@
 package nft
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis sets nft information for genesis.
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	// collections are written in the layout of the exported store
+	if data.StoreMigrated {
+		k.MigrateNFTStore(ctx)
+	}
+
 	k.SetOwners(ctx, data.Owners)
 
 	for _, c := range data.Collections {
 		sortedCollection := NewCollection(c.Denom, c.NFTs.Sort())
 		k.SetCollection(ctx, c.Denom, sortedCollection)
 	}
+
+	for _, subToken := range data.SubTokens {
+		k.SetSubToken(ctx, subToken.CollectionDenom, subToken.NftID, subToken.TokenID, subToken.Reserve)
+	}
+
+	for _, last := range data.LastSubTokenIds {
+		k.SetLastSubTokenID(ctx, last.CollectionDenom, last.NftID, last.LastTokenTokenID)
+	}
+
+	for _, tokenID := range data.TokenIds {
+		k.SetTokenIDIndex(ctx, tokenID.NftID)
+	}
+
+	for _, info := range data.CollectionInfos {
+		k.SetCollectionInfo(ctx, info)
+	}
+
+	for _, listing := range data.Listings {
+		k.SetListing(ctx, listing)
+		if listing.ID > k.GetLastListingID(ctx) {
+			k.SetLastListingID(ctx, listing.ID)
+		}
+	}
+
+	for _, approval := range data.SubTokenApprovals {
+		k.SetSubTokenApproval(ctx, approval)
+	}
+
+	for _, approval := range data.OperatorApprovals {
+		k.SetOperatorApproval(ctx, approval)
+	}
+
+	// the reserved pool balance is restored by the auth genesis, so it can be checked only here.
+	// The pool may hold more than the reserves, as checked by the reserve invariant.
+	reserve := data.TotalReserve()
+	pool := k.GetReservedPool(ctx).GetCoins().AmountOf(*k.BaseDenom)
+	if pool.LT(reserve) {
+		panic(fmt.Sprintf("%s module account balance %s is less than sub-tokens reserve %s", ReservedPool, pool, reserve))
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -20,5 +66,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	lastSubTokenIds := k.GetLastSubTokenIDs(ctx)
 	tokenIds := k.GetAllTokenIDs(ctx)
 
-	return NewGenesisState(k.GetOwners(ctx), k.GetCollections(ctx), subTokens, lastSubTokenIds, tokenIds)
+	genesisState := NewGenesisState(k.GetOwners(ctx), k.GetCollections(ctx), subTokens, lastSubTokenIds, tokenIds, k.GetCollectionInfos(ctx), k.GetListings(ctx),
+		k.GetSubTokenApprovals(ctx), k.GetOperatorApprovals(ctx))
+	genesisState.StoreMigrated = k.IsNFTStoreMigrated(ctx)
+	return genesisState
 }
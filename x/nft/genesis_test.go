@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/keeper"
 )
 
 func TestInitGenesis(t *testing.T) {
@@ -41,7 +43,8 @@ func TestInitGenesis(t *testing.T) {
 
 	collections := NewCollections(collection, collection2)
 
-	genesisState = NewGenesisState(owners, collections)
+	genesisState = NewGenesisState(owners, collections, nil, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, ValidateGenesis(genesisState))
 
 	InitGenesis(ctx, NFTKeeper, genesisState)
 
@@ -64,3 +67,95 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Collections[0].String(), exportedGenesisState.Collections[0].String())
 	require.Equal(t, genesisState.Collections[1].String(), exportedGenesisState.Collections[1].String())
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	_, err = NFTKeeper.MintNFT(ctx, Denom2, ID2, sdk.NewInt(200), sdk.NewInt(2), Addrs[1], Addrs[1], TokenURI2, true)
+	require.NoError(t, err)
+	NFTKeeper.SetTokenIDIndex(ctx, ID1)
+	NFTKeeper.SetTokenIDIndex(ctx, ID2)
+
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	nft, err = types.TransferNFT(nft, Addrs[0], Addrs[2], []int64{2})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+	require.NoError(t, NFTKeeper.ApproveSubTokens(ctx, Addrs[2], Denom1, ID1, []int64{2}, Addrs[3]))
+
+	exported := ExportGenesis(ctx, NFTKeeper)
+	require.NoError(t, ValidateGenesis(exported))
+	require.Len(t, exported.SubTokens, 5)
+	require.Len(t, exported.LastSubTokenIds, 2)
+	require.Len(t, exported.TokenIds, 2)
+
+	// the reserved pool balance comes from the auth genesis
+	ctx2, NFTKeeper2 := keeper.CreateTestInput(t, false, 10000000)
+	require.NoError(t, NFTKeeper2.ReserveTokens(ctx2, sdk.NewCoins(sdk.NewCoin(*NFTKeeper2.BaseDenom, exported.TotalReserve())), Addrs[0]))
+
+	InitGenesis(ctx2, NFTKeeper2, exported)
+
+	reserve, found := NFTKeeper2.GetSubToken(ctx2, Denom2, ID2, 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(200), reserve)
+	require.Equal(t, int64(4), NFTKeeper2.GetLastSubTokenID(ctx2, Denom1, ID1))
+	require.True(t, NFTKeeper2.ExistTokenID(ctx2, ID1))
+
+	reexported := ExportGenesis(ctx2, NFTKeeper2)
+	require.Equal(t, string(ModuleCdc.MustMarshalJSON(exported)), string(ModuleCdc.MustMarshalJSON(reexported)))
+
+	// the genesis keeps the layout of the migrated store
+	require.False(t, exported.StoreMigrated)
+	NFTKeeper2.MigrateNFTStore(ctx2)
+	migrated := ExportGenesis(ctx2, NFTKeeper2)
+	require.True(t, migrated.StoreMigrated)
+
+	// the reserved pool may hold more than the reserves
+	ctx4, NFTKeeper4 := keeper.CreateTestInput(t, false, 10000000)
+	require.NoError(t, NFTKeeper4.ReserveTokens(ctx4, sdk.NewCoins(sdk.NewCoin(*NFTKeeper4.BaseDenom, migrated.TotalReserve().AddRaw(1))), Addrs[0]))
+	InitGenesis(ctx4, NFTKeeper4, migrated)
+	require.True(t, NFTKeeper4.IsNFTStoreMigrated(ctx4))
+	reexported = ExportGenesis(ctx4, NFTKeeper4)
+	require.Equal(t, string(ModuleCdc.MustMarshalJSON(migrated)), string(ModuleCdc.MustMarshalJSON(reexported)))
+
+	// the genesis can not be imported when the reserved pool does not hold the reserves
+	ctx3, NFTKeeper3 := keeper.CreateTestInput(t, false, 10000000)
+	require.Panics(t, func() { InitGenesis(ctx3, NFTKeeper3, exported) })
+}
+
+func TestValidateGenesis(t *testing.T) {
+	nft := types.NewBaseNFT(ID1, Addrs[0], Addrs[0], TokenURI1, sdk.NewInt(100), []int64{1}, true)
+	collections := NewCollections(NewCollection(Denom1, types.NewNFTs(nft)))
+	owners := []Owner{types.NewOwner(Addrs[0], types.NewIDCollection(Denom1, []string{ID1}))}
+	subTokens := []types.SubToken{{CollectionDenom: Denom1, NftID: ID1, TokenID: 1, Reserve: sdk.NewInt(100)}}
+	lastSubTokenIDs := []types.LastSubTokenId{{CollectionDenom: Denom1, NftID: ID1, LastTokenTokenID: 2}}
+	tokenIDs := []types.TokenId{{CollectionDenom: Denom1, NftID: ID1}}
+
+	genesisState := NewGenesisState(owners, collections, subTokens, lastSubTokenIDs, tokenIDs, nil, nil, nil, nil)
+	require.NoError(t, ValidateGenesis(genesisState))
+	require.Equal(t, sdk.NewInt(100), genesisState.TotalReserve())
+
+	// the owner index must match collections
+	invalid := genesisState
+	invalid.Owners = []Owner{types.NewOwner(Addrs[1], types.NewIDCollection(Denom1, []string{ID1}))}
+	require.Error(t, ValidateGenesis(invalid))
+	invalid.Owners = nil
+	require.Error(t, ValidateGenesis(invalid))
+
+	// held sub-tokens must have reserves below the last sub-token ID
+	invalid = genesisState
+	invalid.SubTokens = nil
+	require.Error(t, ValidateGenesis(invalid))
+	invalid.SubTokens = []types.SubToken{{CollectionDenom: Denom1, NftID: ID1, TokenID: 1, Reserve: sdk.NewInt(-1)}}
+	require.Error(t, ValidateGenesis(invalid))
+	invalid.SubTokens = append(subTokens, types.SubToken{CollectionDenom: Denom1, NftID: ID1, TokenID: 2, Reserve: sdk.NewInt(100)})
+	require.Error(t, ValidateGenesis(invalid))
+	invalid.SubTokens = append(subTokens, types.SubToken{CollectionDenom: Denom2, NftID: ID1, TokenID: 1, Reserve: sdk.NewInt(100)})
+	require.Error(t, ValidateGenesis(invalid))
+
+	invalid = genesisState
+	invalid.TokenIds = append(tokenIDs, types.TokenId{CollectionDenom: Denom1, NftID: ID2})
+	require.Error(t, ValidateGenesis(invalid))
+}
@@ -33,6 +33,26 @@ func GenericHandler(k keeper.Keeper) sdk.Handler {
 			return HandleMsgBurnNFT(ctx, msg, k)
 		case types.MsgUpdateReserveNFT:
 			return HandleMsgUpdateReserveNFT(ctx, msg, k)
+		case types.MsgTransferCollectionOwnership:
+			return HandleMsgTransferCollectionOwnership(ctx, msg, k)
+		case types.MsgEditCollectionMetadata:
+			return HandleMsgEditCollectionMetadata(ctx, msg, k)
+		case types.MsgSetCollectionMinter:
+			return HandleMsgSetCollectionMinter(ctx, msg, k)
+		case types.MsgListNFT:
+			return HandleMsgListNFT(ctx, msg, k)
+		case types.MsgCreateAuction:
+			return HandleMsgCreateAuction(ctx, msg, k)
+		case types.MsgCancelListing:
+			return HandleMsgCancelListing(ctx, msg, k)
+		case types.MsgBuyNFT:
+			return HandleMsgBuyNFT(ctx, msg, k)
+		case types.MsgBidNFT:
+			return HandleMsgBidNFT(ctx, msg, k)
+		case types.MsgApproveNFT:
+			return HandleMsgApproveNFT(ctx, msg, k)
+		case types.MsgRevokeNFT:
+			return HandleMsgRevokeNFT(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unrecognized nft message type: %T", msg))
 		}
@@ -47,27 +67,66 @@ func HandleMsgTransferNFT(ctx sdk.Context, msg types.MsgTransferNFT, k keeper.Ke
 		return nil, err
 	}
 
-	nft, err = types.TransferNFT(nft, msg.Sender, msg.Recipient, msg.SubTokenIDs)
+	// the creator can take back sub-tokens of an NFT which holders are not allowed to transfer
+	isRevoke := !nft.IsTransferable(ctx.BlockHeight()) && nft.GetCreator().Equals(msg.Sender) &&
+		msg.Recipient.Equals(msg.Sender) && !msg.Owner.Empty()
+
+	if !msg.Owner.Empty() && !msg.Owner.Equals(msg.Sender) {
+		if !isRevoke {
+			err = k.CheckApproval(ctx, msg.Owner, msg.Denom, msg.ID, msg.SubTokenIDs, msg.Sender)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if nft.GetOwners().GetOwner(msg.Owner) == nil {
+			return nil, types.ErrOwnerDoesNotOwnSubTokenID(msg.Owner.String(), types.SortedIntArray(msg.SubTokenIDs).String())
+		}
+	}
+
+	if !isRevoke {
+		err = types.CheckTransferable(nft, msg.Denom, msg.From(), ctx.BlockHeight())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nft, err = types.TransferNFT(nft, msg.From(), msg.Recipient, msg.SubTokenIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	collection, found := k.GetCollection(ctx, msg.Denom)
-	if !found {
-		return nil, ErrUnknownCollection(msg.Denom)
+	transferEvent := sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+	)
+	if !msg.Owner.Empty() {
+		transferEvent = transferEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()))
+	}
+
+	if msg.Price != nil {
+		royalty, err := k.PayRoyalty(ctx, nft, msg.From(), *msg.Price)
+		if err != nil {
+			return nil, err
+		}
+		transferEvent = transferEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyRoyalty, royalty.String()),
+		)
 	}
 
-	collection.NFTs, _ = collection.NFTs.Update(msg.ID, nft)
+	err = k.UpdateNFT(ctx, msg.Denom, nft)
+	if err != nil {
+		return nil, err
+	}
 
-	k.SetCollection(ctx, msg.Denom, collection)
+	if k.IsNFTStoreMigrated(ctx) {
+		k.ClearSubTokenApprovals(ctx, msg.Denom, msg.ID, msg.SubTokenIDs)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-		),
+		transferEvent,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -116,11 +175,23 @@ func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k k
 // HandleMsgMintNFT handles MsgMintNFT
 func HandleMsgMintNFT(ctx sdk.Context, msg types.MsgMintNFT, k keeper.Keeper,
 ) (*sdk.Result, error) {
+	if !k.CanMint(ctx, msg.Denom, msg.Sender) {
+		return nil, types.ErrNotAllowedCollectionMint(msg.Denom, msg.Sender.String())
+	}
+
 	nft, err := k.GetNFT(ctx, msg.Denom, msg.ID)
 	if err == nil {
 		if !nft.GetCreator().Equals(msg.Sender) || !nft.GetAllowMint() {
 			return nil, ErrNotAllowedMint()
 		}
+		if msg.RoyaltyBasisPoints != 0 && (!nft.GetRoyaltyRecipient().Equals(msg.RoyaltyRecipient) ||
+			nft.GetRoyaltyBasisPoints() != msg.RoyaltyBasisPoints) {
+			return nil, types.ErrRoyaltyImmutable(msg.Denom, msg.ID)
+		}
+		if (msg.NonTransferable || msg.TransferLockedUntil != 0) && (nft.IsNonTransferable() != msg.NonTransferable ||
+			nft.GetTransferLockedUntil() != msg.TransferLockedUntil) {
+			return nil, types.ErrTransferRestrictionsImmutable(msg.Denom, msg.ID)
+		}
 	} else {
 		if k.ExistTokenURI(ctx, msg.TokenURI) {
 			return nil, ErrNotUniqueTokenURI()
@@ -143,20 +214,46 @@ func HandleMsgMintNFT(ctx sdk.Context, msg types.MsgMintNFT, k keeper.Keeper,
 		}
 	}
 
+	isNew := err != nil
+
 	lastSubTokenID, err := k.MintNFT(ctx, msg.Denom, msg.ID, msg.Reserve, msg.Quantity, msg.Sender, msg.Recipient, msg.TokenURI, msg.AllowMint)
 	if err != nil {
 		return nil, err
 	}
 
+	mintEvent := sdk.NewEvent(
+		types.EventTypeMintNFT,
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+		sdk.NewAttribute(types.AttributeKeyNFTTokenURI, msg.TokenURI),
+		sdk.NewAttribute(types.AttributeKeySubTokenIDStartRange, strconv.FormatInt(lastSubTokenID-msg.Quantity.Int64(), 10)),
+	)
+
+	if isNew && msg.RoyaltyBasisPoints != 0 {
+		err = k.SetNFTRoyalty(ctx, msg.Denom, msg.ID, msg.RoyaltyRecipient, msg.RoyaltyBasisPoints)
+		if err != nil {
+			return nil, err
+		}
+		mintEvent = mintEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyRoyaltyRecipient, msg.RoyaltyRecipient.String()),
+			sdk.NewAttribute(types.AttributeKeyRoyaltyBasisPoints, strconv.FormatUint(msg.RoyaltyBasisPoints, 10)),
+		)
+	}
+
+	if isNew && (msg.NonTransferable || msg.TransferLockedUntil != 0) {
+		err = k.SetNFTTransferRestrictions(ctx, msg.Denom, msg.ID, msg.NonTransferable, msg.TransferLockedUntil)
+		if err != nil {
+			return nil, err
+		}
+		mintEvent = mintEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyTransferable, strconv.FormatBool(!msg.NonTransferable)),
+			sdk.NewAttribute(types.AttributeKeyTransferLockedUntil, strconv.FormatInt(msg.TransferLockedUntil, 10)),
+		)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMintNFT,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-			sdk.NewAttribute(types.AttributeKeyNFTTokenURI, msg.TokenURI),
-			sdk.NewAttribute(types.AttributeKeySubTokenIDStartRange, strconv.FormatInt(lastSubTokenID-msg.Quantity.Int64(), 10)),
-		),
+		mintEvent,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -174,6 +271,10 @@ func HandleMsgBurnNFT(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
 		return nil, err
 	}
 
+	if k.IsNFTStoreMigrated(ctx) {
+		return handleMsgBurnSubTokens(ctx, msg, k)
+	}
+
 	if !nft.GetCreator().Equals(msg.Sender) {
 		return nil, ErrNotAllowedBurn()
 	}
@@ -199,6 +300,32 @@ func HandleMsgBurnNFT(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// handleMsgBurnSubTokens burns sub-tokens held by the sender and returns their reserve to the sender
+func handleMsgBurnSubTokens(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	reserve, err := k.BurnSubTokens(ctx, msg.Denom, msg.ID, msg.Sender, msg.SubTokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeySubTokenIDs, types.SortedIntArray(msg.SubTokenIDs).String()),
+			sdk.NewAttribute(types.AttributeKeyReserve, sdk.NewCoin(*k.BaseDenom, reserve).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func HandleMsgUpdateReserveNFT(ctx sdk.Context, msg types.MsgUpdateReserveNFT, k keeper.Keeper,
 ) (*sdk.Result, error) {
 	nft, err := k.GetNFT(ctx, msg.Denom, msg.ID)
@@ -230,3 +357,292 @@ func HandleMsgUpdateReserveNFT(ctx sdk.Context, msg types.MsgUpdateReserveNFT, k
 	})
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// HandleMsgTransferCollectionOwnership handles MsgTransferCollectionOwnership
+func HandleMsgTransferCollectionOwnership(ctx sdk.Context, msg types.MsgTransferCollectionOwnership, k keeper.Keeper,
+) (*sdk.Result, error) {
+	info, found := k.GetCollectionInfo(ctx, msg.Denom)
+	if !found {
+		return nil, ErrUnknownCollection(msg.Denom)
+	}
+
+	if !info.Owner.Equals(msg.Sender) {
+		return nil, types.ErrNotCollectionOwner(msg.Denom, msg.Sender.String())
+	}
+
+	info.Owner = msg.NewOwner
+	k.SetCollectionInfo(ctx, info)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferCollectionOwnership,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.NewOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgEditCollectionMetadata handles MsgEditCollectionMetadata
+func HandleMsgEditCollectionMetadata(ctx sdk.Context, msg types.MsgEditCollectionMetadata, k keeper.Keeper,
+) (*sdk.Result, error) {
+	info, found := k.GetCollectionInfo(ctx, msg.Denom)
+	if !found {
+		return nil, ErrUnknownCollection(msg.Denom)
+	}
+
+	if !info.Owner.Equals(msg.Sender) {
+		return nil, types.ErrNotCollectionOwner(msg.Denom, msg.Sender.String())
+	}
+
+	info.Name = msg.Name
+	info.Description = msg.Description
+	info.URI = msg.URI
+	k.SetCollectionInfo(ctx, info)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditCollectionMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgSetCollectionMinter handles MsgSetCollectionMinter
+func HandleMsgSetCollectionMinter(ctx sdk.Context, msg types.MsgSetCollectionMinter, k keeper.Keeper,
+) (*sdk.Result, error) {
+	info, found := k.GetCollectionInfo(ctx, msg.Denom)
+	if !found {
+		return nil, ErrUnknownCollection(msg.Denom)
+	}
+
+	if !info.Owner.Equals(msg.Sender) {
+		return nil, types.ErrNotCollectionOwner(msg.Denom, msg.Sender.String())
+	}
+
+	info = info.SetMinter(msg.Minter, msg.Allowed)
+	k.SetCollectionInfo(ctx, info)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCollectionMinter,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyMinter, msg.Minter.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowed, strconv.FormatBool(msg.Allowed)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgListNFT handles MsgListNFT
+func HandleMsgListNFT(ctx sdk.Context, msg types.MsgListNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	listing, err := k.CreateListing(ctx, msg.Sender, msg.Denom, msg.ID, msg.SubTokenIDs, msg.Price, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeListNFT,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(listing.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySubTokenIDs, types.SortedIntArray(listing.SubTokenIDs).String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgCreateAuction handles MsgCreateAuction
+func HandleMsgCreateAuction(ctx sdk.Context, msg types.MsgCreateAuction, k keeper.Keeper,
+) (*sdk.Result, error) {
+	listing, err := k.CreateListing(ctx, msg.Sender, msg.Denom, msg.ID, msg.SubTokenIDs, msg.ReservePrice, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateAuction,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(listing.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySubTokenIDs, types.SortedIntArray(listing.SubTokenIDs).String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.ReservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(msg.EndHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgCancelListing handles MsgCancelListing
+func HandleMsgCancelListing(ctx sdk.Context, msg types.MsgCancelListing, k keeper.Keeper,
+) (*sdk.Result, error) {
+	_, err := k.CancelListing(ctx, msg.ListingID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelListing,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(msg.ListingID, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgBuyNFT handles MsgBuyNFT
+func HandleMsgBuyNFT(ctx sdk.Context, msg types.MsgBuyNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	listing, royalty, err := k.BuyListing(ctx, msg.ListingID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBuyNFT,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(msg.ListingID, 10)),
+			sdk.NewAttribute(types.AttributeKeySeller, listing.Seller.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, listing.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, listing.NFTID),
+			sdk.NewAttribute(types.AttributeKeyPrice, listing.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyRoyalty, royalty.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgBidNFT handles MsgBidNFT
+func HandleMsgBidNFT(ctx sdk.Context, msg types.MsgBidNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	_, err := k.BidOnAuction(ctx, msg.ListingID, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidNFT,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(msg.ListingID, 10)),
+			sdk.NewAttribute(types.AttributeKeyBid, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgApproveNFT handles MsgApproveNFT
+func HandleMsgApproveNFT(ctx sdk.Context, msg types.MsgApproveNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	// approvals are cleared on the change of ownership only once the NFT store is migrated
+	if !k.IsNFTStoreMigrated(ctx) {
+		return nil, types.ErrApprovalsNotEnabled()
+	}
+
+	if len(msg.ID) == 0 {
+		if !k.HasCollection(ctx, msg.Denom) {
+			return nil, ErrUnknownCollection(msg.Denom)
+		}
+		k.SetOperatorApproval(ctx, types.NewOperatorApproval(msg.Sender, msg.Denom, msg.Operator))
+	} else {
+		err := k.ApproveSubTokens(ctx, msg.Sender, msg.Denom, msg.ID, msg.SubTokenIDs, msg.Operator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newApprovalEvent(types.EventTypeApproveNFT, msg.Operator, msg.Denom, msg.ID, msg.SubTokenIDs),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgRevokeNFT handles MsgRevokeNFT
+func HandleMsgRevokeNFT(ctx sdk.Context, msg types.MsgRevokeNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	if len(msg.ID) == 0 {
+		if !k.IsOperatorApproved(ctx, msg.Sender, msg.Denom, msg.Operator) {
+			return nil, types.ErrInvalidOperator(msg.Operator.String())
+		}
+		k.DeleteOperatorApproval(ctx, msg.Sender, msg.Denom, msg.Operator)
+	} else {
+		err := k.RevokeSubTokens(ctx, msg.Sender, msg.Denom, msg.ID, msg.SubTokenIDs, msg.Operator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newApprovalEvent(types.EventTypeRevokeNFT, msg.Operator, msg.Denom, msg.ID, msg.SubTokenIDs),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func newApprovalEvent(eventType string, operator sdk.AccAddress, denom, id string, subTokenIDs []int64) sdk.Event {
+	event := sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+	)
+	if len(id) > 0 {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyNFTID, id),
+			sdk.NewAttribute(types.AttributeKeySubTokenIDs, types.SortedIntArray(subTokenIDs).String()),
+		)
+	}
+	return event
+}
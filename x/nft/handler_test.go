@@ -363,3 +363,345 @@ func TestUniqueTokenID(t *testing.T) {
 	_, err = HandleMsgMintNFT(ctx, msg, nftKeeper)
 	require.Error(t, types.ErrNotUniqueTokenID(), err)
 }
+
+func TestCollectionOwnershipMsgs(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	nftKeeper.MigrateNFTStore(ctx)
+	h := GenericHandler(nftKeeper)
+
+	reserve := sdk.NewInt(100)
+
+	// the first minter becomes the owner of the collection
+	_, err := nftKeeper.MintNFT(ctx, Denom1, ID1, reserve, sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	info, found := nftKeeper.GetCollectionInfo(ctx, Denom1)
+	require.True(t, found)
+	require.Equal(t, Addrs[0], info.Owner)
+
+	// strangers can not mint into the collection
+	msg := types.NewMsgMintNFT(Addrs[1], Addrs[1], ID2, Denom1, TokenURI2, sdk.NewInt(1), types.NewMinReserve2, false)
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, types.ErrNotAllowedCollectionMint(Denom1, Addrs[1].String()).Error(), err.Error())
+
+	// only the owner manages the collection
+	_, err = h(ctx, types.NewMsgSetCollectionMinter(Addrs[1], Denom1, Addrs[1], true))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgEditCollectionMetadata(Addrs[1], Denom1, "name", "", ""))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgTransferCollectionOwnership(Addrs[1], Denom1, Addrs[2]))
+	require.Error(t, err)
+
+	_, err = h(ctx, types.NewMsgSetCollectionMinter(Addrs[0], Denom1, Addrs[1], true))
+	require.NoError(t, err)
+	require.True(t, nftKeeper.CanMint(ctx, Denom1, Addrs[1]))
+
+	_, err = h(ctx, types.NewMsgEditCollectionMetadata(Addrs[0], Denom1, "Collection", "Description", "https://google.com/collection.json"))
+	require.NoError(t, err)
+	info, _ = nftKeeper.GetCollectionInfo(ctx, Denom1)
+	require.Equal(t, "Collection", info.Name)
+	require.Equal(t, "Description", info.Description)
+	require.Equal(t, "https://google.com/collection.json", info.URI)
+
+	_, err = h(ctx, types.NewMsgTransferCollectionOwnership(Addrs[0], Denom1, Addrs[2]))
+	require.NoError(t, err)
+	info, _ = nftKeeper.GetCollectionInfo(ctx, Denom1)
+	require.Equal(t, Addrs[0], info.Creator)
+	require.Equal(t, Addrs[2], info.Owner)
+
+	// the previous owner loses its permissions
+	require.False(t, nftKeeper.CanMint(ctx, Denom1, Addrs[0]))
+	_, err = h(ctx, types.NewMsgSetCollectionMinter(Addrs[0], Denom1, Addrs[1], false))
+	require.Error(t, err)
+
+	_, err = h(ctx, types.NewMsgSetCollectionMinter(Addrs[2], Denom1, Addrs[1], false))
+	require.NoError(t, err)
+	require.False(t, nftKeeper.CanMint(ctx, Denom1, Addrs[1]))
+}
+
+func TestBurnNFTMsgByHolder(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	nftKeeper.MigrateNFTStore(ctx)
+	h := GenericHandler(nftKeeper)
+
+	reserve := sdk.NewInt(100)
+	_, err := nftKeeper.MintNFT(ctx, Denom1, ID1, reserve, sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{2}))
+	require.NoError(t, err)
+
+	// the creator can not burn sub-tokens it does not hold anymore
+	_, err = h(ctx, types.NewMsgBurnNFT(Addrs[0], ID1, Denom1, []int64{2}))
+	require.Error(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := h(ctx, types.NewMsgBurnNFT(Addrs[1], ID1, Denom1, []int64{2}))
+	require.NoError(t, err)
+	require.True(t, CheckInvariants(nftKeeper, ctx))
+
+	for _, event := range res.Events {
+		// reserve is returned by the bank transfer with its own events
+		if event.Type != types.EventTypeBurnNFT {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			value := string(attribute.Value)
+			switch key := string(attribute.Key); key {
+			case denom:
+				require.Equal(t, value, Denom1)
+			case nftID:
+				require.Equal(t, value, ID1)
+			case types.AttributeKeyOwner:
+				require.Equal(t, value, Addrs[1].String())
+			case types.AttributeKeySubTokenIDs:
+				require.Equal(t, value, "2")
+			case types.AttributeKeyReserve:
+				require.Equal(t, value, sdk.NewCoin(*nftKeeper.BaseDenom, reserve).String())
+			default:
+				require.Fail(t, fmt.Sprintf("unrecognized event %s", key))
+			}
+		}
+	}
+
+	// the creator still burns sub-tokens it holds
+	_, err = h(ctx, types.NewMsgBurnNFT(Addrs[0], ID1, Denom1, []int64{1}))
+	require.NoError(t, err)
+
+	msg, broken := AllInvariants(nftKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestRoyaltyMsgs(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	h := GenericHandler(nftKeeper)
+
+	reserve := sdk.NewInt(101)
+	mintNFT := types.NewMsgMintNFT(Addrs[0], Addrs[0], ID1, Denom1, TokenURI1, sdk.NewInt(2), reserve, true).
+		WithRoyalty(Addrs[2], 500)
+	_, err := h(ctx, mintNFT)
+	require.NoError(t, err)
+
+	nft, err := nftKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[2], nft.GetRoyaltyRecipient())
+	require.Equal(t, uint64(500), nft.GetRoyaltyBasisPoints())
+
+	// the royalty can not be changed by minting more sub-tokens
+	_, err = h(ctx, mintNFT.WithRoyalty(Addrs[1], 100))
+	require.Error(t, err)
+
+	price := sdk.NewCoin(*nftKeeper.BaseDenom, sdk.NewInt(1000))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := h(ctx, types.NewMsgTransferNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1}).WithPrice(price))
+	require.NoError(t, err)
+
+	found := false
+	for _, event := range res.Events {
+		if event.Type != types.EventTypeTransfer {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			value := string(attribute.Value)
+			switch string(attribute.Key) {
+			case types.AttributeKeyPrice:
+				require.Equal(t, price.String(), value)
+			case types.AttributeKeyRoyalty:
+				require.Equal(t, sdk.NewCoin(*nftKeeper.BaseDenom, sdk.NewInt(50)).String(), value)
+				found = true
+			}
+		}
+	}
+	require.True(t, found)
+
+	// the owner pays the royalty of the sub-tokens transferred by the approved operator without coins
+	nftKeeper.MigrateNFTStore(ctx)
+	operator := types.CreateTestAddrs(len(Addrs) + 1)[len(Addrs)]
+	_, err = h(ctx, types.NewMsgApproveNFT(Addrs[1], operator, Denom1, ID1, []int64{1}))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(operator, Addrs[3], Denom1, ID1, []int64{1}).WithOwner(Addrs[1]).WithPrice(price))
+	require.NoError(t, err)
+
+	msg, broken := AllInvariants(nftKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestTransferRestrictionMsgs(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	nftKeeper.MigrateNFTStore(ctx)
+	h := GenericHandler(nftKeeper)
+
+	reserve := sdk.NewInt(100)
+	_, err := nftKeeper.MintNFT(ctx, Denom1, ID1, reserve, sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	err = nftKeeper.SetNFTTransferRestrictions(ctx, Denom1, ID1, true, 0)
+	require.NoError(t, err)
+
+	nft, err := nftKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.True(t, nft.IsNonTransferable())
+
+	// the restrictions can not be changed by minting more sub-tokens
+	mintNFT := types.NewMsgMintNFT(Addrs[0], Addrs[0], ID1, Denom1, TokenURI1, sdk.NewInt(1), reserve, true)
+	_, err = h(ctx, mintNFT.WithTransferRestrictions(false, 100))
+	require.Error(t, err)
+	err = nftKeeper.SetNFTTransferRestrictions(ctx, Denom1, ID1, false, 0)
+	require.Error(t, err)
+
+	// the creator distributes sub-tokens
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1, 2}))
+	require.NoError(t, err)
+
+	// holders can neither transfer, list nor approve an operator to transfer them
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID1, []int64{1}))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgListNFT(Addrs[1], Denom1, ID1, []int64{1}, sdk.NewCoin(*nftKeeper.BaseDenom, sdk.NewInt(1000))))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgApproveNFT(Addrs[1], Addrs[2], Denom1, ID1, []int64{1}))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[2], Addrs[2], Denom1, ID1, []int64{1}).WithOwner(Addrs[1]))
+	require.Error(t, err)
+
+	// the creator revokes a sub-token and burns it
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[0], Addrs[0], Denom1, ID1, []int64{1}).WithOwner(Addrs[1]))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgBurnNFT(Addrs[0], ID1, Denom1, []int64{1}))
+	require.NoError(t, err)
+
+	nft, err = nftKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+	require.Equal(t, []int64{2}, nft.GetOwners().GetOwner(Addrs[1]).GetSubTokenIDs())
+
+	// a locked NFT becomes transferable at the lock height
+	_, err = nftKeeper.MintNFT(ctx, Denom1, ID2, reserve, sdk.NewInt(1), Addrs[0], Addrs[1], TokenURI2, true)
+	require.NoError(t, err)
+	err = nftKeeper.SetNFTTransferRestrictions(ctx, Denom1, ID2, false, updates.Update13Block+10)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID2, []int64{1}))
+	require.Error(t, err)
+	_, err = h(ctx.WithBlockHeight(updates.Update13Block+10), types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID2, []int64{1}))
+	require.NoError(t, err)
+
+	msg, broken := AllInvariants(nftKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMarketMsgs(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	h := GenericHandler(nftKeeper)
+
+	_, err := nftKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	price := sdk.NewCoin(*nftKeeper.BaseDenom, sdk.NewInt(1000))
+	_, err = h(ctx, types.NewMsgCreateAuction(Addrs[0], Denom1, ID1, []int64{1}, price, 15))
+	require.NoError(t, err)
+
+	// escrowed sub-tokens can not be transferred by the seller
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1}))
+	require.Error(t, err)
+
+	_, err = h(ctx, types.NewMsgBidNFT(Addrs[1], 1, price))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, nftKeeper)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCloseAuction {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyStatus {
+				require.Equal(t, types.ListingStatusSold, string(attribute.Value))
+				found = true
+			}
+		}
+	}
+	require.True(t, found)
+
+	// the buyer can list bought sub-tokens again
+	_, err = h(ctx, types.NewMsgListNFT(Addrs[1], Denom1, ID1, []int64{1}, price))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgBuyNFT(Addrs[2], 2))
+	require.NoError(t, err)
+
+	nft, err := nftKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, nft.GetOwners().GetOwner(Addrs[2]).GetSubTokenIDs())
+
+	msg, broken := AllInvariants(nftKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestApprovalMsgs(t *testing.T) {
+	ctx, _, nftKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	h := GenericHandler(nftKeeper)
+
+	// approvals are not accepted before the migration, as they would survive the transfers
+	_, err := h(ctx, types.NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, "", nil))
+	require.Error(t, err)
+	nftKeeper.MigrateNFTStore(ctx)
+
+	_, err = nftKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	// not approved operators can not transfer sub-tokens of the owner
+	transfer := types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID1, []int64{1}).WithOwner(Addrs[0])
+	_, err = h(ctx, transfer)
+	require.Error(t, err)
+
+	_, err = h(ctx, types.NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1}))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID1, []int64{1, 2}).WithOwner(Addrs[0]))
+	require.Error(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = h(ctx, transfer)
+	require.NoError(t, err)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTransfer {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyOwner {
+				require.Equal(t, Addrs[0].String(), string(attribute.Value))
+				found = true
+			}
+		}
+	}
+	require.True(t, found)
+
+	nft, err := nftKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, nft.GetOwners().GetOwner(Addrs[2]).GetSubTokenIDs())
+
+	// the approval is cleared with the transfer
+	require.Len(t, nftKeeper.GetNFTApprovals(ctx, Denom1, ID1), 0)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[0], Denom1, ID1, []int64{1}).WithOwner(Addrs[2]))
+	require.Error(t, err)
+
+	// operators approved for the collection can transfer any sub-tokens of the owner
+	_, err = h(ctx, types.NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, "", nil))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[1], Denom1, ID1, []int64{2}).WithOwner(Addrs[0]))
+	require.NoError(t, err)
+
+	_, err = h(ctx, types.NewMsgRevokeNFT(Addrs[0], Addrs[1], Denom1, "", nil))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgRevokeNFT(Addrs[0], Addrs[1], Denom1, "", nil))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgTransferNFT(Addrs[1], Addrs[2], Denom1, ID1, []int64{3}).WithOwner(Addrs[0]))
+	require.Error(t, err)
+
+	msg, broken := AllInvariants(nftKeeper)(ctx)
+	require.False(t, broken, msg)
+}
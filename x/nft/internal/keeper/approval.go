@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+// GetSubTokenApproval returns the approval of the sub-token
+func (k Keeper) GetSubTokenApproval(ctx sdk.Context, denom, id string, subTokenID int64) (approval types.SubTokenApproval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSubTokenApprovalKey(denom, id, subTokenID))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &approval)
+	return approval, true
+}
+
+// SetSubTokenApproval sets the approval of the sub-token replacing the previous one
+func (k Keeper) SetSubTokenApproval(ctx sdk.Context, approval types.SubTokenApproval) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(approval)
+	store.Set(types.GetSubTokenApprovalKey(approval.Denom, approval.ID, approval.SubTokenID), bz)
+}
+
+// ClearSubTokenApprovals removes the approvals of the sub-tokens. It must be called whenever sub-tokens change their owner.
+func (k Keeper) ClearSubTokenApprovals(ctx sdk.Context, denom, id string, subTokenIDs []int64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, subTokenID := range subTokenIDs {
+		store.Delete(types.GetSubTokenApprovalKey(denom, id, subTokenID))
+	}
+}
+
+// GetNFTApprovals returns the approvals of all the sub-tokens of the NFT
+func (k Keeper) GetNFTApprovals(ctx sdk.Context, denom, id string) types.SubTokenApprovals {
+	return k.getSubTokenApprovals(ctx, types.GetNFTApprovalsKey(denom, id))
+}
+
+// GetSubTokenApprovals returns all the sub-token approvals
+func (k Keeper) GetSubTokenApprovals(ctx sdk.Context) types.SubTokenApprovals {
+	return k.getSubTokenApprovals(ctx, types.SubTokenApprovalPrefix)
+}
+
+func (k Keeper) getSubTokenApprovals(ctx sdk.Context, prefix []byte) types.SubTokenApprovals {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	approvals := make(types.SubTokenApprovals, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.SubTokenApproval
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
+
+// SetOperatorApproval approves the operator to transfer all the sub-tokens of the collection held by the owner
+func (k Keeper) SetOperatorApproval(ctx sdk.Context, approval types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(approval)
+	store.Set(types.GetOperatorApprovalKey(approval.Owner, approval.Denom, approval.Operator), bz)
+}
+
+// DeleteOperatorApproval removes the operator approval
+func (k Keeper) DeleteOperatorApproval(ctx sdk.Context, owner sdk.AccAddress, denom string, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOperatorApprovalKey(owner, denom, operator))
+}
+
+// IsOperatorApproved returns whether the operator is approved to transfer all the sub-tokens of the collection held by the owner
+func (k Keeper) IsOperatorApproved(ctx sdk.Context, owner sdk.AccAddress, denom string, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetOperatorApprovalKey(owner, denom, operator))
+}
+
+// GetOperators returns the operators approved by the owner for the collection
+func (k Keeper) GetOperators(ctx sdk.Context, owner sdk.AccAddress, denom string) types.OperatorApprovals {
+	return k.getOperatorApprovals(ctx, types.GetOperatorApprovalsKey(owner, denom))
+}
+
+// GetOperatorApprovals returns all the operator approvals
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context) types.OperatorApprovals {
+	return k.getOperatorApprovals(ctx, types.OperatorApprovalPrefix)
+}
+
+func (k Keeper) getOperatorApprovals(ctx sdk.Context, prefix []byte) types.OperatorApprovals {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	approvals := make(types.OperatorApprovals, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
+
+// ApproveSubTokens approves the operator to transfer the sub-tokens held by the owner
+func (k Keeper) ApproveSubTokens(ctx sdk.Context, owner sdk.AccAddress, denom, id string, subTokenIDs []int64, operator sdk.AccAddress) error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	tokenOwner := nft.GetOwners().GetOwner(owner)
+	for _, subTokenID := range subTokenIDs {
+		if tokenOwner == nil || types.SortedIntArray(tokenOwner.GetSubTokenIDs()).Find(subTokenID) == -1 {
+			return types.ErrOwnerDoesNotOwnSubTokenID(owner.String(), strconv.FormatInt(subTokenID, 10))
+		}
+		k.SetSubTokenApproval(ctx, types.NewSubTokenApproval(denom, id, subTokenID, owner, operator))
+	}
+	return nil
+}
+
+// RevokeSubTokens removes the approvals of the operator to transfer the sub-tokens held by the owner
+func (k Keeper) RevokeSubTokens(ctx sdk.Context, owner sdk.AccAddress, denom, id string, subTokenIDs []int64, operator sdk.AccAddress) error {
+	for _, subTokenID := range subTokenIDs {
+		approval, found := k.GetSubTokenApproval(ctx, denom, id, subTokenID)
+		if !found || !approval.Owner.Equals(owner) || !approval.Operator.Equals(operator) {
+			return types.ErrNotApproved(operator.String(), owner.String(), denom, id, subTokenID)
+		}
+	}
+	k.ClearSubTokenApprovals(ctx, denom, id, subTokenIDs)
+	return nil
+}
+
+// CheckApproval returns an error if the operator is not allowed to transfer the sub-tokens held by the owner
+// either by the operator approval for the collection or by the approvals of every sub-token
+func (k Keeper) CheckApproval(ctx sdk.Context, owner sdk.AccAddress, denom, id string, subTokenIDs []int64, operator sdk.AccAddress) error {
+	if k.IsOperatorApproved(ctx, owner, denom, operator) {
+		return nil
+	}
+	for _, subTokenID := range subTokenIDs {
+		approval, found := k.GetSubTokenApproval(ctx, denom, id, subTokenID)
+		if !found || !approval.Owner.Equals(owner) || !approval.Operator.Equals(operator) {
+			return types.ErrNotApproved(operator.String(), owner.String(), denom, id, subTokenID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+func TestSubTokenApprovals(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	// only held sub-tokens can be approved
+	require.Error(t, NFTKeeper.ApproveSubTokens(ctx, Addrs[1], Denom1, ID1, []int64{1}, Addrs[2]))
+	require.Error(t, NFTKeeper.ApproveSubTokens(ctx, Addrs[0], Denom1, ID1, []int64{4}, Addrs[2]))
+
+	require.NoError(t, NFTKeeper.ApproveSubTokens(ctx, Addrs[0], Denom1, ID1, []int64{1, 2}, Addrs[2]))
+	require.Len(t, NFTKeeper.GetNFTApprovals(ctx, Denom1, ID1), 2)
+
+	require.NoError(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1, 2}, Addrs[2]))
+	require.Error(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1, 3}, Addrs[2]))
+	require.Error(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1}, Addrs[1]))
+
+	require.Error(t, NFTKeeper.RevokeSubTokens(ctx, Addrs[0], Denom1, ID1, []int64{2}, Addrs[1]))
+	require.NoError(t, NFTKeeper.RevokeSubTokens(ctx, Addrs[0], Denom1, ID1, []int64{2}, Addrs[2]))
+	require.Error(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{2}, Addrs[2]))
+
+	// approvals are cleared when sub-tokens change the owner
+	listing, err := NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{1}, sdk.NewCoin(DefaultBondDenom, sdk.NewInt(10)), 0)
+	require.NoError(t, err)
+	_, found := NFTKeeper.GetSubTokenApproval(ctx, Denom1, ID1, 1)
+	require.False(t, found)
+	_, err = NFTKeeper.CancelListing(ctx, listing.ID, Addrs[0])
+	require.NoError(t, err)
+	require.Error(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1}, Addrs[2]))
+
+	require.NoError(t, NFTKeeper.ApproveSubTokens(ctx, Addrs[0], Denom1, ID1, []int64{3}, Addrs[2]))
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[0], []int64{3})
+	require.NoError(t, err)
+	require.Len(t, NFTKeeper.GetSubTokenApprovals(ctx), 0)
+}
+
+func TestOperatorApprovals(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	NFTKeeper.SetOperatorApproval(ctx, types.NewOperatorApproval(Addrs[0], Denom1, Addrs[1]))
+	require.True(t, NFTKeeper.IsOperatorApproved(ctx, Addrs[0], Denom1, Addrs[1]))
+	require.False(t, NFTKeeper.IsOperatorApproved(ctx, Addrs[0], Denom2, Addrs[1]))
+	require.NoError(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1, 2}, Addrs[1]))
+
+	querier := NewQuerier(NFTKeeper)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryBalanceParams(Addrs[0], Denom1))
+	require.NoError(t, err)
+	res, err := querier(ctx, []string{QueryOperators}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+	var operators types.OperatorApprovals
+	types.ModuleCdc.MustUnmarshalJSON(res, &operators)
+	require.Equal(t, types.OperatorApprovals{types.NewOperatorApproval(Addrs[0], Denom1, Addrs[1])}, operators)
+
+	NFTKeeper.DeleteOperatorApproval(ctx, Addrs[0], Denom1, Addrs[1])
+	require.Error(t, NFTKeeper.CheckApproval(ctx, Addrs[0], Denom1, ID1, []int64{1}, Addrs[1]))
+	require.Len(t, NFTKeeper.GetOperatorApprovals(ctx), 0)
+}
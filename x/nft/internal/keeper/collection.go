@@ -1,13 +1,43 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"bitbucket.org/decimalteam/go-node/x/nft/exported"
 	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
 )
 
+// IsNFTStoreMigrated returns whether NFTs are stored individually instead of whole collections.
+// The flag is set by the NFT store migration or by the genesis of a migrated store. It is read
+// without consuming gas, so that checking it doesn't change the gas used by the transactions.
+func (k Keeper) IsNFTStoreMigrated(ctx sdk.Context) bool {
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	return store.Has(types.NFTStoreMigratedKey)
+}
+
+func (k Keeper) setNFTStoreMigrated(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NFTStoreMigratedKey, []byte{1})
+}
+
 // IterateCollections iterates over collections and performs a function
 func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection types.Collection) (stop bool)) {
+	if !k.IsNFTStoreMigrated(ctx) {
+		k.iterateLegacyCollections(ctx, handler)
+		return
+	}
+
+	for _, denom := range k.GetDenoms(ctx) {
+		collection, _ := k.GetCollection(ctx, denom)
+		if handler(collection) {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateLegacyCollections(ctx sdk.Context, handler func(collection types.Collection) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.CollectionsKeyPrefix)
 	defer iterator.Close()
@@ -20,24 +50,63 @@ func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection type
 	}
 }
 
-// SetCollection sets the entire collection of a single denom
+// SetCollection sets the entire collection of a single denom.
+// Once NFTs are stored individually only NFTs of the collection are written, so it must not be used to delete NFTs.
 func (k Keeper) SetCollection(ctx sdk.Context, denom string, collection types.Collection) {
 	store := ctx.KVStore(k.storeKey)
-	collectionKey := types.GetCollectionKey(denom)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(collection)
-	store.Set(collectionKey, bz)
+	if !k.IsNFTStoreMigrated(ctx) {
+		collectionKey := types.GetCollectionKey(denom)
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(collection)
+		store.Set(collectionKey, bz)
+		return
+	}
+
+	store.Set(types.GetDenomKey(denom), []byte(denom))
+	for _, nft := range collection.NFTs {
+		k.SetNFT(ctx, denom, nft)
+	}
 }
 
-// GetCollection returns a collection of NFTs
+// GetCollection returns a collection of NFTs.
+// Once NFTs are stored individually the collection is built by iterating over its NFTs.
 func (k Keeper) GetCollection(ctx sdk.Context, denom string) (collection types.Collection, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	collectionKey := types.GetCollectionKey(denom)
-	bz := store.Get(collectionKey)
-	if bz == nil {
+	if !k.IsNFTStoreMigrated(ctx) {
+		collectionKey := types.GetCollectionKey(denom)
+		bz := store.Get(collectionKey)
+		if bz == nil {
+			return
+		}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
+		return collection, true
+	}
+
+	if !store.Has(types.GetDenomKey(denom)) {
 		return
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
-	return collection, true
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetNFTsKey(denom))
+	var nfts []exported.NFT
+	for ; iterator.Valid(); iterator.Next() {
+		var nft exported.NFT
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+	iterator.Close()
+
+	for i, nft := range nfts {
+		nfts[i] = nft.SetOwners(k.getNFTOwners(ctx, denom, nft.GetID()))
+	}
+	return types.NewCollection(denom, types.NewNFTs(nfts...)), true
+}
+
+// HasCollection returns whether the collection exists
+func (k Keeper) HasCollection(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	if !k.IsNFTStoreMigrated(ctx) {
+		return store.Has(types.GetCollectionKey(denom))
+	}
+	return store.Has(types.GetDenomKey(denom))
 }
 
 // GetCollections returns all the NFTs collections
@@ -53,11 +122,133 @@ func (k Keeper) GetCollections(ctx sdk.Context) (collections []types.Collection)
 
 // GetDenoms returns all the NFT denoms
 func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []string) {
-	k.IterateCollections(ctx,
-		func(collection types.Collection) (stop bool) {
-			denoms = append(denoms, collection.Denom)
-			return false
-		},
-	)
+	if !k.IsNFTStoreMigrated(ctx) {
+		k.IterateCollections(ctx,
+			func(collection types.Collection) (stop bool) {
+				denoms = append(denoms, collection.Denom)
+				return false
+			},
+		)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Value()))
+	}
 	return
 }
+
+// SetNFT stores the NFT and its owners individually. Only changed records are written,
+// so the cost does not depend on the size of the collection.
+func (k Keeper) SetNFT(ctx sdk.Context, denom string, nft exported.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomKey(denom), []byte(denom))
+
+	nftKey := types.GetNFTKey(denom, nft.GetID())
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(nft.SetOwners(&types.TokenOwners{}))
+	storedNFT := store.Get(nftKey)
+	if storedNFT == nil {
+		store.Set(types.GetCreatedNFTKey(nft.GetCreator(), denom, nft.GetID()), k.getTokenIDBytes(denom, nft.GetID()))
+	}
+	if !bytes.Equal(storedNFT, bz) {
+		store.Set(nftKey, bz)
+	}
+
+	ownersKey := types.GetNFTOwnersKey(denom, nft.GetID())
+	stored := make(map[string][]byte)
+	iterator := sdk.KVStorePrefixIterator(store, ownersKey)
+	for ; iterator.Valid(); iterator.Next() {
+		stored[string(iterator.Key())] = iterator.Value()
+	}
+	iterator.Close()
+
+	for _, owner := range nft.GetOwners().GetOwners() {
+		ownerKey := types.GetNFTOwnerKey(denom, nft.GetID(), owner.GetAddress())
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(owner)
+		if !bytes.Equal(stored[string(ownerKey)], bz) {
+			store.Set(ownerKey, bz)
+			k.setHeldNFT(ctx, denom, nft.GetID(), owner.GetAddress(), len(owner.GetSubTokenIDs()) > 0)
+		}
+		delete(stored, string(ownerKey))
+	}
+
+	for ownerKey := range stored {
+		store.Delete([]byte(ownerKey))
+		k.setHeldNFT(ctx, denom, nft.GetID(), sdk.AccAddress(ownerKey[len(ownersKey):]), false)
+	}
+}
+
+// setHeldNFT keeps the NFT in the index of NFTs held by the owner only while the owner holds its sub-tokens
+func (k Keeper) setHeldNFT(ctx sdk.Context, denom, id string, owner sdk.AccAddress, held bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetHeldNFTKey(owner, denom, id)
+	switch {
+	case held && !store.Has(key):
+		store.Set(key, k.getTokenIDBytes(denom, id))
+	case !held && store.Has(key):
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) getTokenIDBytes(denom, id string) []byte {
+	return k.cdc.MustMarshalBinaryLengthPrefixed(types.TokenId{
+		CollectionDenom: denom,
+		NftID:           id,
+	})
+}
+
+func (k Keeper) getNFT(ctx sdk.Context, denom, id string) (exported.NFT, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNFTKey(denom, id))
+	if bz == nil {
+		if !store.Has(types.GetDenomKey(denom)) {
+			return nil, types.ErrUnknownCollection(denom)
+		}
+		return nil, types.ErrUnknownNFT(denom, id)
+	}
+
+	var nft exported.NFT
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
+	return nft.SetOwners(k.getNFTOwners(ctx, denom, id)), nil
+}
+
+func (k Keeper) getNFTOwners(ctx sdk.Context, denom, id string) exported.TokenOwners {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetNFTOwnersKey(denom, id))
+	defer iterator.Close()
+
+	owners := &types.TokenOwners{}
+	for ; iterator.Valid(); iterator.Next() {
+		var owner exported.TokenOwner
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &owner)
+		owners.Owners = append(owners.Owners, owner)
+	}
+	return owners
+}
+
+// MigrateNFTStore moves collections stored as a whole to individual NFT and owner records.
+// It does nothing if the store is already migrated.
+func (k Keeper) MigrateNFTStore(ctx sdk.Context) {
+	if k.IsNFTStoreMigrated(ctx) {
+		return
+	}
+
+	var collections []types.Collection
+	k.iterateLegacyCollections(ctx, func(collection types.Collection) (stop bool) {
+		collections = append(collections, collection)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, collection := range collections {
+		store.Delete(types.GetCollectionKey(collection.Denom))
+		store.Set(types.GetDenomKey(collection.Denom), []byte(collection.Denom))
+		for _, nft := range collection.NFTs {
+			k.SetNFT(ctx, collection.Denom, nft)
+		}
+	}
+	k.setNFTStoreMigrated(ctx)
+}
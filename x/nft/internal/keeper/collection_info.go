@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+// GetCollectionInfo returns ownership and metadata of a collection
+func (k Keeper) GetCollectionInfo(ctx sdk.Context, denom string) (info types.CollectionInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCollectionInfoKey(denom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	return info, true
+}
+
+// SetCollectionInfo sets ownership and metadata of a collection
+func (k Keeper) SetCollectionInfo(ctx sdk.Context, info types.CollectionInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.GetCollectionInfoKey(info.Denom), bz)
+}
+
+// GetCollectionInfos returns ownership and metadata of all collections
+func (k Keeper) GetCollectionInfos(ctx sdk.Context) []types.CollectionInfo {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CollectionInfoKeyPrefix)
+	defer iterator.Close()
+
+	infos := make([]types.CollectionInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.CollectionInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// CanMint returns whether the address may mint into the denom.
+// Anyone may mint into a denom which does not exist yet, or into any denom before the NFT store migration.
+func (k Keeper) CanMint(ctx sdk.Context, denom string, address sdk.AccAddress) bool {
+	if !k.IsNFTStoreMigrated(ctx) {
+		return true
+	}
+	info, found := k.GetCollectionInfo(ctx, denom)
+	if !found {
+		return true
+	}
+	return info.CanMint(address)
+}
+
+// MigrateCollectionInfos creates collection infos for denoms minted before collections had owners.
+// Mint order is not recorded for existing collections, so the creator of the most NFTs of the collection
+// becomes the owner, the creator of the NFT with the lowest ID is chosen among the creators of equally
+// many NFTs. Creators of other NFTs are not put to the allow-list, the owner can add them to it.
+func (k Keeper) MigrateCollectionInfos(ctx sdk.Context) {
+	for _, collection := range k.GetCollections(ctx) {
+		if len(collection.NFTs) == 0 {
+			continue
+		}
+		if _, found := k.GetCollectionInfo(ctx, collection.Denom); found {
+			continue
+		}
+
+		created := make(map[string]int)
+		for _, nft := range collection.NFTs {
+			created[nft.GetCreator().String()]++
+		}
+
+		// NFTs of the collection are sorted by ID
+		var owner sdk.AccAddress
+		for _, nft := range collection.NFTs {
+			if owner == nil || created[nft.GetCreator().String()] > created[owner.String()] {
+				owner = nft.GetCreator()
+			}
+		}
+		k.SetCollectionInfo(ctx, types.NewCollectionInfo(collection.Denom, owner))
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+func TestMigrateCollectionInfos(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	const denom3 = "test_denom3"
+
+	mint := func(denom, id string, creator sdk.AccAddress) {
+		_, err := NFTKeeper.MintNFT(ctx, denom, id, sdk.NewInt(100), sdk.NewInt(1), creator, creator, "https://google.com/token-"+id+".json", false)
+		require.NoError(t, err)
+	}
+
+	// collections minted before the update have no owner
+	mint(Denom1, ID1, Addrs[0])
+	mint(Denom1, ID2, Addrs[1])
+	mint(Denom1, ID3, Addrs[1])
+	mint(Denom2, "4", Addrs[1])
+	mint(Denom2, "5", Addrs[0])
+	_, found := NFTKeeper.GetCollectionInfo(ctx, Denom1)
+	require.False(t, found)
+	require.True(t, NFTKeeper.CanMint(ctx, Denom1, Addrs[2]))
+
+	NFTKeeper.MigrateNFTStore(ctx)
+	NFTKeeper.MigrateCollectionInfos(ctx)
+
+	// the creator of the most NFTs owns the collection, other creators are not allowed to mint
+	info, found := NFTKeeper.GetCollectionInfo(ctx, Denom1)
+	require.True(t, found)
+	require.Equal(t, types.NewCollectionInfo(Denom1, Addrs[1]), info)
+	require.True(t, NFTKeeper.CanMint(ctx, Denom1, Addrs[1]))
+	require.False(t, NFTKeeper.CanMint(ctx, Denom1, Addrs[0]))
+	require.False(t, NFTKeeper.CanMint(ctx, Denom1, Addrs[2]))
+
+	// the creator of the NFT with the lowest ID is chosen among the creators of equally many NFTs
+	info, found = NFTKeeper.GetCollectionInfo(ctx, Denom2)
+	require.True(t, found)
+	require.Equal(t, types.NewCollectionInfo(Denom2, Addrs[1]), info)
+	require.True(t, NFTKeeper.CanMint(ctx, denom3, Addrs[2]))
+
+	// migration keeps already existing infos untouched
+	info.Owner = Addrs[2]
+	NFTKeeper.SetCollectionInfo(ctx, info)
+	NFTKeeper.MigrateCollectionInfos(ctx)
+	info, _ = NFTKeeper.GetCollectionInfo(ctx, Denom2)
+	require.Equal(t, Addrs[2], info.Owner)
+
+	// new collections are owned by their first minter
+	mint(denom3, "6", Addrs[3])
+	info, found = NFTKeeper.GetCollectionInfo(ctx, denom3)
+	require.True(t, found)
+	require.Equal(t, types.NewCollectionInfo(denom3, Addrs[3]), info)
+	require.Len(t, NFTKeeper.GetCollectionInfos(ctx), 3)
+}
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestSetCollection(t *testing.T) {
@@ -116,3 +120,140 @@ func TestGetCollections(t *testing.T) {
 	msg, fail := SupplyInvariant(NFTKeeper)(ctx)
 	require.False(t, fail, msg)
 }
+
+func TestMigrateNFTStore(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	require.False(t, NFTKeeper.IsNFTStoreMigrated(ctx))
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	_, err = NFTKeeper.MintNFT(ctx, Denom1, ID2, sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI2, true)
+	require.NoError(t, err)
+	_, err = NFTKeeper.MintNFT(ctx, Denom2, ID3, sdk.NewInt(100), sdk.NewInt(1), Addrs[1], Addrs[1], TokenURI1, true)
+	require.NoError(t, err)
+
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	nft, err = types.TransferNFT(nft, Addrs[0], Addrs[1], []int64{2})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+
+	denoms := NFTKeeper.GetDenoms(ctx)
+
+	NFTKeeper.MigrateNFTStore(ctx)
+	require.True(t, NFTKeeper.IsNFTStoreMigrated(ctx))
+
+	store := ctx.KVStore(NFTKeeper.storeKey)
+	require.False(t, store.Has(types.GetCollectionKey(Denom1)))
+	require.False(t, store.Has(types.GetCollectionKey(Denom2)))
+	require.Equal(t, denoms, NFTKeeper.GetDenoms(ctx))
+	require.True(t, NFTKeeper.HasCollection(ctx, Denom2))
+
+	collection, found := NFTKeeper.GetCollection(ctx, Denom1)
+	require.True(t, found)
+	require.Len(t, collection.NFTs, 2)
+
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, TokenURI1, nft.GetTokenURI())
+	require.Equal(t, []int64{1, 3}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+	require.Equal(t, []int64{2}, nft.GetOwners().GetOwner(Addrs[1]).GetSubTokenIDs())
+
+	_, err = NFTKeeper.GetNFT(ctx, Denom2, ID1)
+	require.Error(t, err)
+	_, err = NFTKeeper.GetNFT(ctx, Denom3, ID1)
+	require.Error(t, err)
+
+	msg, broken := AllInvariants(NFTKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestNFTStore(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	NFTKeeper.MigrateNFTStore(ctx)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	_, err = NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[1], TokenURI1, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{Denom1}, NFTKeeper.GetDenoms(ctx))
+
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+	require.Equal(t, []int64{3}, nft.GetOwners().GetOwner(Addrs[1]).GetSubTokenIDs())
+
+	nft, err = types.TransferNFT(nft, Addrs[1], Addrs[2], []int64{3})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+
+	// burning all the held sub-tokens removes the owner record
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[0], []int64{1, 2})
+	require.NoError(t, err)
+	store := ctx.KVStore(NFTKeeper.storeKey)
+	require.False(t, store.Has(types.GetNFTOwnerKey(Denom1, ID1, Addrs[0])))
+
+	collection, found := NFTKeeper.GetCollection(ctx, Denom1)
+	require.True(t, found)
+	nft, err = collection.GetNFT(ID1)
+	require.NoError(t, err)
+	require.Nil(t, nft.GetOwners().GetOwner(Addrs[0]))
+	require.Equal(t, []int64{3}, nft.GetOwners().GetOwner(Addrs[2]).GetSubTokenIDs())
+
+	// unknown NFTs can not be updated
+	require.Error(t, NFTKeeper.UpdateNFT(ctx, Denom1, types.NewBaseNFT(ID2, Addrs[0], Addrs[0], TokenURI2, sdk.NewInt(100), []int64{1}, true)))
+	_, found = NFTKeeper.GetCollection(ctx, Denom2)
+	require.False(t, found)
+
+	msg, broken := ReserveInvariant(NFTKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestTransferNFTGasDoesNotDependOnCollectionSize(t *testing.T) {
+	require.Equal(t, transferNFTGas(t, 1), transferNFTGas(t, 100))
+}
+
+func BenchmarkTransferNFT(b *testing.B) {
+	for _, size := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("collection size %d", size), func(b *testing.B) {
+			ctx, NFTKeeper := createTestCollection(b, size)
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				transferNFT(b, ctx, NFTKeeper, Addrs[i%2], Addrs[(i+1)%2])
+			}
+			b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+		})
+	}
+}
+
+// createTestCollection mints the collection of the given size with NFTs stored individually
+func createTestCollection(t testing.TB, size int) (sdk.Context, Keeper) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	NFTKeeper.MigrateNFTStore(ctx)
+
+	for i := 0; i < size; i++ {
+		_, err := NFTKeeper.MintNFT(ctx, Denom1, strconv.Itoa(i), sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI1, true)
+		require.NoError(t, err)
+	}
+	return ctx, NFTKeeper
+}
+
+func transferNFT(t testing.TB, ctx sdk.Context, NFTKeeper Keeper, sender, recipient sdk.AccAddress) {
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, "0")
+	require.NoError(t, err)
+	nft, err = types.TransferNFT(nft, sender, recipient, []int64{1})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+}
+
+func transferNFTGas(t testing.TB, size int) uint64 {
+	ctx, NFTKeeper := createTestCollection(t, size)
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	transferNFT(t, ctx, NFTKeeper, Addrs[0], Addrs[1])
+	return ctx.GasMeter().GasConsumed()
+}
@@ -22,7 +22,7 @@ var (
 	TokenURI2 = "https://google.com/token-2.json"
 )
 
-func createTestApp(t *testing.T, isCheckTx bool) (sdk.Context, *codec.Codec, Keeper) {
+func createTestApp(t testing.TB, isCheckTx bool) (sdk.Context, *codec.Codec, Keeper) {
 	ctx, nftKeeper := CreateTestInput(t, isCheckTx, 10000000)
 
 	return ctx, MakeTestCodec(), nftKeeper
@@ -16,12 +16,20 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		types.ModuleName, "supply",
 		SupplyInvariant(k),
 	)
+	ir.RegisterRoute(
+		types.ModuleName, "reserve",
+		ReserveInvariant(k),
+	)
 }
 
 // AllInvariants runs all invariants of the nfts module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return SupplyInvariant(k)(ctx)
+		res, stop := SupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ReserveInvariant(k)(ctx)
 	}
 }
 
@@ -58,3 +66,49 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 			"%d NFT supply invariants found\n%s", count, msg)), broken
 	}
 }
+
+// ReserveInvariant checks that all the sub-tokens held by owners have reserves and that
+// the reserved pool holds enough coins to return the reserves of all sub-tokens.
+// Delegated sub-tokens are not held by owners but keep their reserves.
+func ReserveInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		total := sdk.ZeroInt()
+
+		k.IterateCollections(ctx, func(collection types.Collection) bool {
+			for _, nft := range collection.NFTs {
+				held := make(map[int64]bool)
+				for _, owner := range nft.GetOwners().GetOwners() {
+					for _, subTokenID := range owner.GetSubTokenIDs() {
+						held[subTokenID] = true
+					}
+				}
+
+				lastSubTokenID := k.GetLastSubTokenID(ctx, collection.Denom, nft.GetID())
+				for subTokenID := int64(1); subTokenID < lastSubTokenID; subTokenID++ {
+					reserve, found := k.GetSubToken(ctx, collection.Denom, nft.GetID(), subTokenID)
+					if found {
+						total = total.Add(reserve)
+					}
+					if held[subTokenID] && !found {
+						count++
+						msg += fmt.Sprintf("\tsub-token %d of NFT %s/%s: held %t, reserve found %t\n",
+							subTokenID, collection.Denom, nft.GetID(), held[subTokenID], found)
+					}
+				}
+			}
+			return false
+		})
+
+		pool := k.GetReservedPool(ctx).GetCoins().AmountOf(*k.BaseDenom)
+		if pool.LT(total) {
+			count++
+			msg += fmt.Sprintf("\treserved pool %s is less than sub-tokens reserve %s\n", pool, total)
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "reserve", fmt.Sprintf(
+			"%d NFT reserve invariants found\n%s", count, msg)), broken
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,15 +22,18 @@ type Keeper struct {
 
 	supplyKeeper supply.Keeper
 
+	bankKeeper bank.Keeper
+
 	BaseDenom *string
 }
 
 // NewKeeper creates new instances of the nft Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper supply.Keeper, baseDenom string) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper supply.Keeper, bankKeeper bank.Keeper, baseDenom string) Keeper {
 	return Keeper{
 		storeKey:     storeKey,
 		cdc:          cdc,
 		supplyKeeper: supplyKeeper,
+		bankKeeper:   bankKeeper,
 		BaseDenom:    &baseDenom,
 	}
 }
@@ -0,0 +1,371 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+// GetMarketPool returns the module account holding listed sub-tokens and auction bids
+func (k Keeper) GetMarketPool(ctx sdk.Context) exported.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.MarketPool)
+}
+
+// GetListing returns the listing by its ID
+func (k Keeper) GetListing(ctx sdk.Context, id uint64) (listing types.Listing, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetListingKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &listing)
+	return listing, true
+}
+
+// SetListing sets the listing and keeps its indexes up to date
+func (k Keeper) SetListing(ctx sdk.Context, listing types.Listing) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetListingKey(listing.ID), k.cdc.MustMarshalBinaryLengthPrefixed(listing))
+
+	id := sdk.Uint64ToBigEndian(listing.ID)
+	store.Set(types.GetDenomListingKey(listing.Denom, listing.ID), id)
+	store.Set(types.GetSellerListingKey(listing.Seller, listing.ID), id)
+	if listing.Type == types.ListingTypeAuction {
+		if listing.IsActive() {
+			store.Set(types.GetAuctionQueueKey(listing.EndHeight, listing.ID), id)
+		} else {
+			store.Delete(types.GetAuctionQueueKey(listing.EndHeight, listing.ID))
+		}
+	}
+}
+
+// GetListings returns all the listings
+func (k Keeper) GetListings(ctx sdk.Context) types.Listings {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ListingKeyPrefix)
+	defer iterator.Close()
+
+	listings := make(types.Listings, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.Listing
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &listing)
+		listings = append(listings, listing)
+	}
+	return listings
+}
+
+// GetListingsByDenom returns all the listings of the collection
+func (k Keeper) GetListingsByDenom(ctx sdk.Context, denom string) types.Listings {
+	return k.getIndexedListings(ctx, types.GetDenomListingsKey(denom))
+}
+
+// GetListingsBySeller returns all the listings of the seller
+func (k Keeper) GetListingsBySeller(ctx sdk.Context, seller sdk.AccAddress) types.Listings {
+	return k.getIndexedListings(ctx, types.GetSellerListingsKey(seller))
+}
+
+func (k Keeper) getIndexedListings(ctx sdk.Context, prefix []byte) types.Listings {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	listings := make(types.Listings, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		listing, found := k.GetListing(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			listings = append(listings, listing)
+		}
+	}
+	return listings
+}
+
+// GetLastListingID returns the ID of the last created listing
+func (k Keeper) GetLastListingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastListingIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastListingID sets the ID of the last created listing
+func (k Keeper) SetLastListingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastListingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateListing escrows the sub-tokens of the seller in the market pool and creates a fixed price listing
+// or an auction of them if the end height is set
+func (k Keeper) CreateListing(ctx sdk.Context, seller sdk.AccAddress, denom, id string, subTokenIDs []int64,
+	price sdk.Coin, endHeight int64) (types.Listing, error) {
+	if endHeight != 0 && endHeight <= ctx.BlockHeight() {
+		return types.Listing{}, types.ErrInvalidEndHeight(endHeight)
+	}
+
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return types.Listing{}, err
+	}
+	err = types.CheckTransferable(nft, denom, seller, ctx.BlockHeight())
+	if err != nil {
+		return types.Listing{}, err
+	}
+
+	err = k.moveSubTokens(ctx, denom, id, seller, k.GetMarketPool(ctx).GetAddress(), subTokenIDs)
+	if err != nil {
+		return types.Listing{}, err
+	}
+
+	listingID := k.GetLastListingID(ctx) + 1
+	k.SetLastListingID(ctx, listingID)
+
+	var listing types.Listing
+	if endHeight != 0 {
+		listing = types.NewAuction(listingID, seller, denom, id, subTokenIDs, price, endHeight)
+	} else {
+		listing = types.NewFixedPriceListing(listingID, seller, denom, id, subTokenIDs, price)
+	}
+	k.SetListing(ctx, listing)
+	return listing, nil
+}
+
+// CancelListing returns the escrowed sub-tokens to the seller. Auctions can be cancelled only before the first bid.
+func (k Keeper) CancelListing(ctx sdk.Context, listingID uint64, sender sdk.AccAddress) (types.Listing, error) {
+	listing, err := k.getActiveListing(ctx, listingID)
+	if err != nil {
+		return listing, err
+	}
+	if !listing.Seller.Equals(sender) {
+		return listing, types.ErrNotListingSeller(listingID, sender.String())
+	}
+	if listing.HasBid() {
+		return listing, types.ErrAuctionHasBids(listingID)
+	}
+
+	err = k.moveSubTokens(ctx, listing.Denom, listing.NFTID, k.GetMarketPool(ctx).GetAddress(), listing.Seller, listing.SubTokenIDs)
+	if err != nil {
+		return listing, err
+	}
+
+	listing.Status = types.ListingStatusCancelled
+	k.SetListing(ctx, listing)
+	return listing, nil
+}
+
+// BuyListing sells the sub-tokens of the fixed price listing to the buyer.
+// The price is paid to the seller and the royalty of the NFT is paid from it.
+func (k Keeper) BuyListing(ctx sdk.Context, listingID uint64, buyer sdk.AccAddress) (types.Listing, sdk.Coin, error) {
+	listing, err := k.getActiveListing(ctx, listingID)
+	if err != nil {
+		return listing, sdk.Coin{}, err
+	}
+	if listing.Type != types.ListingTypeFixedPrice {
+		return listing, sdk.Coin{}, types.ErrInvalidListingType(listingID, listing.Type)
+	}
+	if listing.Seller.Equals(buyer) {
+		return listing, sdk.Coin{}, types.ErrSellerCannotBuy(listingID)
+	}
+
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.MarketPool, sdk.NewCoins(listing.Price))
+	if err != nil {
+		return listing, sdk.Coin{}, err
+	}
+
+	listing.Buyer = buyer
+	listing.Bid = listing.Price
+	royalty, err := k.settleListing(ctx, listing)
+	if err != nil {
+		return listing, sdk.Coin{}, err
+	}
+
+	listing.Status = types.ListingStatusSold
+	k.SetListing(ctx, listing)
+	return listing, royalty, nil
+}
+
+// BidOnAuction places the bid of the bidder. The bid is escrowed in the market pool
+// and the previous highest bid is returned to its bidder.
+func (k Keeper) BidOnAuction(ctx sdk.Context, listingID uint64, bidder sdk.AccAddress, amount sdk.Coin) (types.Listing, error) {
+	listing, err := k.getActiveListing(ctx, listingID)
+	if err != nil {
+		return listing, err
+	}
+	if listing.Type != types.ListingTypeAuction {
+		return listing, types.ErrInvalidListingType(listingID, listing.Type)
+	}
+	if ctx.BlockHeight() >= listing.EndHeight {
+		return listing, types.ErrAuctionEnded(listingID, listing.EndHeight)
+	}
+	if listing.Seller.Equals(bidder) {
+		return listing, types.ErrSellerCannotBuy(listingID)
+	}
+	minBid := listing.MinBid()
+	if amount.Denom != minBid.Denom || amount.IsLT(minBid) {
+		return listing, types.ErrBidTooLow(amount.String(), minBid.String())
+	}
+
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.MarketPool, sdk.NewCoins(amount))
+	if err != nil {
+		return listing, err
+	}
+	if listing.HasBid() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MarketPool, listing.Buyer, sdk.NewCoins(listing.Bid))
+		if err != nil {
+			return listing, err
+		}
+	}
+
+	listing.Buyer = bidder
+	listing.Bid = amount
+	k.SetListing(ctx, listing)
+	return listing, nil
+}
+
+// CloseEndedAuctions settles all the auctions ending at the current height or earlier.
+// The sub-tokens are sold to the highest bidder or returned to the seller if there were no bids.
+// An auction that fails to settle is closed as failed, its escrowed sub-tokens are returned to the seller
+// and its escrowed bid to the bidder.
+func (k Keeper) CloseEndedAuctions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AuctionQueueKeyPrefix, types.GetAuctionQueueEndKey(ctx.BlockHeight()))
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		listing, found := k.GetListing(ctx, id)
+		if !found || !listing.IsActive() {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+		var royalty sdk.Coin
+		var err error
+		if listing.HasBid() {
+			royalty, err = k.settleListing(cacheCtx, listing)
+			listing.Status = types.ListingStatusSold
+		} else {
+			err = k.moveSubTokens(cacheCtx, listing.Denom, listing.NFTID, k.GetMarketPool(ctx).GetAddress(), listing.Seller, listing.SubTokenIDs)
+			listing.Status = types.ListingStatusExpired
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to close auction %d: %s", listing.ID, err))
+			k.refundListing(ctx, listing)
+			listing.Status = types.ListingStatusFailed
+			k.SetListing(ctx, listing)
+		} else {
+			k.SetListing(cacheCtx, listing)
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeCloseAuction,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(listing.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, listing.Status),
+		)
+		if listing.Status == types.ListingStatusSold {
+			event = event.AppendAttributes(
+				sdk.NewAttribute(types.AttributeKeyBuyer, listing.Buyer.String()),
+				sdk.NewAttribute(types.AttributeKeyBid, listing.Bid.String()),
+				sdk.NewAttribute(types.AttributeKeyRoyalty, royalty.String()),
+			)
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+}
+
+// settleListing moves the escrowed sub-tokens to the buyer and pays the escrowed bid
+// to the seller and the royalty recipient of the NFT
+func (k Keeper) settleListing(ctx sdk.Context, listing types.Listing) (sdk.Coin, error) {
+	pool := k.GetMarketPool(ctx).GetAddress()
+	err := k.moveSubTokens(ctx, listing.Denom, listing.NFTID, pool, listing.Buyer, listing.SubTokenIDs)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	nft, err := k.GetNFT(ctx, listing.Denom, listing.NFTID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	royalty := types.CalculateRoyalty(nft, listing.Bid)
+	if !royalty.IsZero() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MarketPool, nft.GetRoyaltyRecipient(), sdk.NewCoins(royalty))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	proceeds := listing.Bid.Sub(royalty)
+	if !proceeds.IsZero() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MarketPool, listing.Seller, sdk.NewCoins(proceeds))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	return royalty, nil
+}
+
+// refundListing returns the escrowed sub-tokens to the seller and the escrowed bid to the bidder
+// of the auction failed to settle. What can not be returned is left in the market pool.
+func (k Keeper) refundListing(ctx sdk.Context, listing types.Listing) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.moveSubTokens(cacheCtx, listing.Denom, listing.NFTID, k.GetMarketPool(ctx).GetAddress(), listing.Seller, listing.SubTokenIDs)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to return sub-tokens of auction %d: %s", listing.ID, err))
+	} else {
+		writeCache()
+	}
+
+	if !listing.HasBid() {
+		return
+	}
+	cacheCtx, writeCache = ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.MarketPool, listing.Buyer, sdk.NewCoins(listing.Bid))
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to return bid of auction %d: %s", listing.ID, err))
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func (k Keeper) getActiveListing(ctx sdk.Context, listingID uint64) (types.Listing, error) {
+	listing, found := k.GetListing(ctx, listingID)
+	if !found {
+		return listing, types.ErrUnknownListing(listingID)
+	}
+	if !listing.IsActive() {
+		return listing, types.ErrListingNotActive(listingID, listing.Status)
+	}
+	return listing, nil
+}
+
+func (k Keeper) moveSubTokens(ctx sdk.Context, denom, id string, sender, recipient sdk.AccAddress, subTokenIDs []int64) error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+	if nft.GetOwners().GetOwner(sender) == nil {
+		return types.ErrOwnerDoesNotOwnSubTokenID(sender.String(), fmt.Sprint(subTokenIDs))
+	}
+	nft, err = types.TransferNFT(nft, sender, recipient, subTokenIDs)
+	if err != nil {
+		return err
+	}
+	k.ClearSubTokenApprovals(ctx, denom, id, subTokenIDs)
+	return k.UpdateNFT(ctx, denom, nft)
+}
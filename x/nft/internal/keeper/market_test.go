@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+func TestFixedPriceListing(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.SetNFTRoyalty(ctx, Denom1, ID1, Addrs[2], 1000))
+
+	price := sdk.NewCoin(DefaultBondDenom, sdk.NewInt(1000))
+
+	// only held sub-tokens can be listed
+	_, err = NFTKeeper.CreateListing(ctx, Addrs[1], Denom1, ID1, []int64{1}, price, 0)
+	require.Error(t, err)
+
+	listing, err := NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{1, 2}, price, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), listing.ID)
+	require.Equal(t, types.ListingStatusActive, listing.Status)
+
+	// listed sub-tokens are escrowed and can not be moved by the seller
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	_, err = types.TransferNFT(nft, Addrs[0], Addrs[1], []int64{1})
+	require.Error(t, err)
+	require.Equal(t, []int64{1, 2}, nft.GetOwners().GetOwner(NFTKeeper.GetMarketPool(ctx).GetAddress()).GetSubTokenIDs())
+
+	// the seller can not buy its own listing and others can not cancel it
+	_, _, err = NFTKeeper.BuyListing(ctx, listing.ID, Addrs[0])
+	require.Error(t, err)
+	_, err = NFTKeeper.CancelListing(ctx, listing.ID, Addrs[1])
+	require.Error(t, err)
+
+	sellerBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[0]).AmountOf(DefaultBondDenom)
+	buyerBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom)
+	recipientBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[2]).AmountOf(DefaultBondDenom)
+
+	listing, royalty, err := NFTKeeper.BuyListing(ctx, listing.ID, Addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, types.ListingStatusSold, listing.Status)
+	require.Equal(t, sdk.NewCoin(DefaultBondDenom, sdk.NewInt(100)), royalty)
+
+	require.Equal(t, sellerBefore.AddRaw(900), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[0]).AmountOf(DefaultBondDenom))
+	require.Equal(t, buyerBefore.SubRaw(1000), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom))
+	require.Equal(t, recipientBefore.AddRaw(100), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[2]).AmountOf(DefaultBondDenom))
+
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, nft.GetOwners().GetOwner(Addrs[1]).GetSubTokenIDs())
+
+	// closed listings can not be bought again
+	_, _, err = NFTKeeper.BuyListing(ctx, listing.ID, Addrs[2])
+	require.Error(t, err)
+
+	// cancelled listings return sub-tokens to the seller
+	listing, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{3}, price, 0)
+	require.NoError(t, err)
+	listing, err = NFTKeeper.CancelListing(ctx, listing.ID, Addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.ListingStatusCancelled, listing.Status)
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+
+	msg, broken := AllInvariants(NFTKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestAuction(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	reservePrice := sdk.NewCoin(DefaultBondDenom, sdk.NewInt(500))
+
+	_, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{1}, reservePrice, 10)
+	require.Error(t, err)
+
+	auction, err := NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{1}, reservePrice, 20)
+	require.NoError(t, err)
+	require.Equal(t, types.ListingTypeAuction, auction.Type)
+
+	// auctions can not be bought at a fixed price
+	_, _, err = NFTKeeper.BuyListing(ctx, auction.ID, Addrs[1])
+	require.Error(t, err)
+
+	// bids must reach the reserve price and outbid the highest bid
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], sdk.NewCoin(DefaultBondDenom, sdk.NewInt(499)))
+	require.Error(t, err)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], sdk.NewCoin("other", sdk.NewInt(1000)))
+	require.Error(t, err)
+
+	bidderBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], reservePrice)
+	require.NoError(t, err)
+	require.Equal(t, bidderBefore.SubRaw(500), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom))
+
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[2], reservePrice)
+	require.Error(t, err)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[2], sdk.NewCoin(DefaultBondDenom, sdk.NewInt(600)))
+	require.NoError(t, err)
+
+	// the outbid bidder gets its bid back
+	require.Equal(t, bidderBefore, NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom))
+
+	// auctions with bids can not be cancelled
+	_, err = NFTKeeper.CancelListing(ctx, auction.ID, Addrs[0])
+	require.Error(t, err)
+
+	// auctions are settled only at the end height
+	NFTKeeper.CloseEndedAuctions(ctx.WithBlockHeight(19))
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.True(t, auction.IsActive())
+
+	sellerBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[0]).AmountOf(DefaultBondDenom)
+	ctx = ctx.WithBlockHeight(20)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], sdk.NewCoin(DefaultBondDenom, sdk.NewInt(700)))
+	require.Error(t, err)
+
+	NFTKeeper.CloseEndedAuctions(ctx)
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.Equal(t, types.ListingStatusSold, auction.Status)
+	require.Equal(t, Addrs[2], auction.Buyer)
+	require.Equal(t, sellerBefore.AddRaw(600), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[0]).AmountOf(DefaultBondDenom))
+
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, nft.GetOwners().GetOwner(Addrs[2]).GetSubTokenIDs())
+
+	// an auction that fails to settle is closed as failed and returns the sub-tokens to the seller,
+	// the bid missing in the market pool can not be returned
+	auction, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{2}, reservePrice, 25)
+	require.NoError(t, err)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], reservePrice)
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MarketPool, Addrs[3], sdk.NewCoins(reservePrice)))
+	NFTKeeper.CloseEndedAuctions(ctx.WithBlockHeight(25))
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.Equal(t, types.ListingStatusFailed, auction.Status)
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+
+	// the failed auction is not settled again, the missing bid is left to be returned by hand
+	require.NoError(t, NFTKeeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, Addrs[3], types.MarketPool, sdk.NewCoins(reservePrice)))
+	NFTKeeper.CloseEndedAuctions(ctx.WithBlockHeight(26))
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.Equal(t, types.ListingStatusFailed, auction.Status)
+	require.Equal(t, sdk.NewCoins(reservePrice), NFTKeeper.GetMarketPool(ctx).GetCoins())
+
+	require.NoError(t, NFTKeeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MarketPool, Addrs[1], sdk.NewCoins(reservePrice)))
+
+	// the bid of an auction failed to settle is returned to the bidder
+	auction, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{2}, reservePrice, 27)
+	require.NoError(t, err)
+	_, err = NFTKeeper.BidOnAuction(ctx, auction.ID, Addrs[1], reservePrice)
+	require.NoError(t, err)
+	bidderBefore = NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom)
+	require.NoError(t, NFTKeeper.moveSubTokens(ctx, Denom1, ID1, NFTKeeper.GetMarketPool(ctx).GetAddress(), Addrs[3], []int64{2}))
+	NFTKeeper.CloseEndedAuctions(ctx.WithBlockHeight(27))
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.Equal(t, types.ListingStatusFailed, auction.Status)
+	require.Equal(t, bidderBefore.AddRaw(500), NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[1]).AmountOf(DefaultBondDenom))
+
+	// auctions without bids expire and return sub-tokens to the seller
+	auction, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{3}, reservePrice, 30)
+	require.NoError(t, err)
+	NFTKeeper.CloseEndedAuctions(ctx.WithBlockHeight(30))
+	auction, _ = NFTKeeper.GetListing(ctx, auction.ID)
+	require.Equal(t, types.ListingStatusExpired, auction.Status)
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, nft.GetOwners().GetOwner(Addrs[0]).GetSubTokenIDs())
+
+	require.True(t, NFTKeeper.GetMarketPool(ctx).GetCoins().IsZero())
+	msg, broken := AllInvariants(NFTKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestQueryListings(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	_, err = NFTKeeper.MintNFT(ctx, Denom2, ID2, sdk.NewInt(100), sdk.NewInt(1), Addrs[1], Addrs[1], TokenURI2, true)
+	require.NoError(t, err)
+
+	price := sdk.NewCoin(DefaultBondDenom, sdk.NewInt(1000))
+	_, err = NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{1}, price, 0)
+	require.NoError(t, err)
+	cancelled, err := NFTKeeper.CreateListing(ctx, Addrs[0], Denom1, ID1, []int64{2}, price, 0)
+	require.NoError(t, err)
+	_, err = NFTKeeper.CancelListing(ctx, cancelled.ID, Addrs[0])
+	require.NoError(t, err)
+	_, err = NFTKeeper.CreateListing(ctx, Addrs[1], Denom2, ID2, []int64{1}, price, 0)
+	require.NoError(t, err)
+
+	require.Len(t, NFTKeeper.GetListings(ctx), 3)
+	require.Len(t, NFTKeeper.GetListingsByDenom(ctx, Denom1), 2)
+	require.Len(t, NFTKeeper.GetListingsBySeller(ctx, Addrs[1]), 1)
+
+	querier := NewQuerier(NFTKeeper)
+	query := func(params types.QueryListingsParams) types.Listings {
+		bz, err := types.ModuleCdc.MarshalJSON(params)
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{QueryListings}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var listings types.Listings
+		types.ModuleCdc.MustUnmarshalJSON(res, &listings)
+		return listings
+	}
+
+	require.Len(t, query(types.NewQueryListingsParams("", nil, "")), 3)
+	require.Len(t, query(types.NewQueryListingsParams(Denom1, nil, types.ListingStatusActive)), 1)
+	require.Len(t, query(types.NewQueryListingsParams(Denom1, Addrs[1], "")), 0)
+	require.Len(t, query(types.NewQueryListingsParams("", Addrs[0], types.ListingStatusCancelled)), 1)
+
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryListingsParams("", nil, "unknown"))
+	require.NoError(t, err)
+	_, err = querier(ctx, []string{QueryListings}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
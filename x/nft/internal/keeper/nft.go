@@ -4,6 +4,7 @@ import (
 	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +21,10 @@ func (k Keeper) IsNFT(ctx sdk.Context, denom, id string) (exists bool) {
 
 // GetNFT gets the entire NFT metadata struct for a uint64
 func (k Keeper) GetNFT(ctx sdk.Context, denom, id string) (exported.NFT, error) {
+	if k.IsNFTStoreMigrated(ctx) {
+		return k.getNFT(ctx, denom, id)
+	}
+
 	collection, found := k.GetCollection(ctx, denom)
 	if !found {
 		return nil, types.ErrUnknownCollection(denom)
@@ -183,9 +188,9 @@ func (k Keeper) GetAllTokenIDs(ctx sdk.Context) []types.TokenId {
 func (k Keeper) MintNFT(ctx sdk.Context, denom, id string, reserve, quantity sdk.Int,
 	creator, owner sdk.AccAddress, tokenURI string, allowMint bool) (int64, error) {
 
-	nft, err := k.GetNFT(ctx, denom, id)
+	existing, err := k.GetNFT(ctx, denom, id)
 	if err == nil {
-		reserve = nft.GetReserve()
+		reserve = existing.GetReserve()
 	}
 
 	lastSubTokenID := k.GetLastSubTokenID(ctx, denom, id)
@@ -201,17 +206,38 @@ func (k Keeper) MintNFT(ctx sdk.Context, denom, id string, reserve, quantity sdk
 		tempSubTokenID++
 	}
 
-	nft = types.NewBaseNFT(id, creator, owner, tokenURI, reserve, subTokenIDs, allowMint)
-	collection, found := k.GetCollection(ctx, denom)
-	if found {
+	var nft exported.NFT = types.NewBaseNFT(id, creator, owner, tokenURI, reserve, subTokenIDs, allowMint)
+	if k.IsNFTStoreMigrated(ctx) {
+		// merge the minted sub-tokens into the stored NFT without loading the whole collection
+		collection := types.NewCollection(denom, types.NewNFTs())
+		if existing != nil {
+			collection = types.NewCollection(denom, types.NewNFTs(existing))
+		}
 		collection, err = collection.AddNFT(nft)
 		if err != nil {
 			return 0, err
 		}
+		nft, _ = collection.GetNFT(id)
+		k.SetNFT(ctx, denom, nft)
 	} else {
-		collection = types.NewCollection(denom, types.NewNFTs(nft))
+		collection, found := k.GetCollection(ctx, denom)
+		if found {
+			collection, err = collection.AddNFT(nft)
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			collection = types.NewCollection(denom, types.NewNFTs(nft))
+		}
+		k.SetCollection(ctx, denom, collection)
+	}
+
+	// collection infos are kept since the NFT store migration, which creates them for the existing collections
+	if k.IsNFTStoreMigrated(ctx) {
+		if _, found := k.GetCollectionInfo(ctx, denom); !found {
+			k.SetCollectionInfo(ctx, types.NewCollectionInfo(denom, creator))
+		}
 	}
-	k.SetCollection(ctx, denom, collection)
 
 	if ctx.BlockHeight() >= updates.Update11Block {
 		k.SetTokenIDIndex(ctx, id)
@@ -244,6 +270,14 @@ func (k Keeper) MintNFT(ctx sdk.Context, denom, id string, reserve, quantity sdk
 
 // UpdateNFT updates an already existing NFTs
 func (k Keeper) UpdateNFT(ctx sdk.Context, denom string, nft exported.NFT) (err error) {
+	if k.IsNFTStoreMigrated(ctx) {
+		if _, err := k.GetNFT(ctx, denom, nft.GetID()); err != nil {
+			return err
+		}
+		k.SetNFT(ctx, denom, nft)
+		return nil
+	}
+
 	collection, found := k.GetCollection(ctx, denom)
 	if !found {
 		return types.ErrUnknownCollection(denom)
@@ -260,7 +294,7 @@ func (k Keeper) UpdateNFT(ctx sdk.Context, denom string, nft exported.NFT) (err
 	return nil
 }
 
-// DeleteNFT deletes an existing NFT from store
+// DeleteNFT burns sub-tokens of an existing NFT held by the NFT creator
 func (k Keeper) DeleteNFT(ctx sdk.Context, denom, id string, subTokenIDs []int64) error {
 	collection, found := k.GetCollection(ctx, denom)
 	if !found {
@@ -332,13 +366,58 @@ func (k Keeper) DeleteNFT(ctx sdk.Context, denom, id string, subTokenIDs []int64
 	return nil
 }
 
+// BurnSubTokens burns sub-tokens of an existing NFT held by the holder and returns their reserve
+// from the reserved pool back to the holder. The holder is removed from the NFT owners once all
+// of its sub-tokens are burnt.
+func (k Keeper) BurnSubTokens(ctx sdk.Context, denom, id string, holder sdk.AccAddress, subTokenIDs []int64) (sdk.Int, error) {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	owner := nft.GetOwners().GetOwner(holder)
+	if owner == nil {
+		return sdk.Int{}, types.ErrNotAllowedBurn()
+	}
+
+	reserveForReturn := sdk.ZeroInt()
+	for _, subTokenID := range subTokenIDs {
+		if types.SortedIntArray(owner.GetSubTokenIDs()).Find(subTokenID) == -1 {
+			return sdk.Int{}, types.ErrOwnerDoesNotOwnSubTokenID(holder.String(), strconv.FormatInt(subTokenID, 10))
+		}
+		reserve, ok := k.GetSubToken(ctx, denom, id, subTokenID)
+		if !ok {
+			return sdk.Int{}, fmt.Errorf("subToken with ID = %d not found", subTokenID)
+		}
+		owner = owner.RemoveSubTokenID(subTokenID)
+		reserveForReturn = reserveForReturn.Add(reserve)
+		k.RemoveSubToken(ctx, denom, id, subTokenID)
+	}
+	k.ClearSubTokenApprovals(ctx, denom, id, subTokenIDs)
+
+	if len(owner.GetSubTokenIDs()) == 0 {
+		// the NFT stays in the collection, so it is kept in the owner index under its creator
+		nft = nft.SetOwners(nft.GetOwners().RemoveOwner(holder))
+	} else {
+		nft = nft.SetOwners(nft.GetOwners().SetOwner(owner))
+	}
+
+	err = k.UpdateNFT(ctx, denom, nft)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ReservedPool, holder, sdk.NewCoins(sdk.NewCoin(*k.BaseDenom, reserveForReturn)))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return reserveForReturn, nil
+}
+
 //UpdateNFTReserve function to increase the minimum reserve of the NFT token
 func (k Keeper) UpdateNFTReserve(ctx sdk.Context, denom, id string, subTokenIDs []int64, newReserve sdk.Int) error {
-	collection, found := k.GetCollection(ctx, denom)
-	if !found {
-		return types.ErrUnknownCollection(denom)
-	}
-	nft, err := collection.GetNFT(id)
+	nft, err := k.GetNFT(ctx, denom, id)
 	if err != nil {
 		return err
 	}
@@ -194,6 +194,66 @@ func TestDeleteNFT(t *testing.T) {
 	require.Equal(t, 1, owner.Supply())
 }
 
+func TestBurnSubTokens(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	NFTKeeper.MigrateNFTStore(ctx)
+
+	reserve := sdk.NewInt(100)
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, reserve, sdk.NewInt(3), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	collection, _ := NFTKeeper.GetCollection(ctx, Denom1)
+	nft, err := collection.GetNFT(ID1)
+	require.NoError(t, err)
+	nft, err = types.TransferNFT(nft, Addrs[0], Addrs[1], []int64{2, 3})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+
+	poolBefore := NFTKeeper.GetReservedPool(ctx).GetCoins().AmountOf(*NFTKeeper.BaseDenom)
+
+	// holders can burn only their own sub-tokens
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[1], []int64{1})
+	require.Error(t, err)
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[2], []int64{2})
+	require.Error(t, err)
+
+	returned, err := NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[1], []int64{2})
+	require.NoError(t, err)
+	require.Equal(t, reserve, returned)
+
+	_, found := NFTKeeper.GetSubToken(ctx, Denom1, ID1, 2)
+	require.False(t, found)
+	poolAfter := NFTKeeper.GetReservedPool(ctx).GetCoins().AmountOf(*NFTKeeper.BaseDenom)
+	require.Equal(t, poolBefore.Sub(reserve), poolAfter)
+
+	// the holder is removed from the NFT owners after burning all of its sub-tokens
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[1], []int64{3})
+	require.NoError(t, err)
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Nil(t, nft.GetOwners().GetOwner(Addrs[1]))
+
+	// the creator still burns sub-tokens it holds
+	ownerIDCollection, _ := NFTKeeper.GetOwnerByDenom(ctx, Addrs[0], Denom1)
+	require.True(t, ownerIDCollection.Exists(ID1))
+	_, err = NFTKeeper.BurnSubTokens(ctx, Denom1, ID1, Addrs[0], []int64{1})
+	require.NoError(t, err)
+	require.True(t, NFTKeeper.IsNFT(ctx, Denom1, ID1))
+
+	// the NFT left in the collection stays listed under its creator, so the state is exported
+	ownerIDCollection, _ = NFTKeeper.GetOwnerByDenom(ctx, Addrs[0], Denom1)
+	require.True(t, ownerIDCollection.Exists(ID1))
+	genesisState := types.NewGenesisState(NFTKeeper.GetOwners(ctx), NFTKeeper.GetCollections(ctx), NFTKeeper.GetSubTokens(ctx),
+		NFTKeeper.GetLastSubTokenIDs(ctx), NFTKeeper.GetAllTokenIDs(ctx), NFTKeeper.GetCollectionInfos(ctx), NFTKeeper.GetListings(ctx),
+		NFTKeeper.GetSubTokenApprovals(ctx), NFTKeeper.GetOperatorApprovals(ctx))
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	msg, broken := AllInvariants(NFTKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestIsNFT(t *testing.T) {
 	ctx, _, NFTKeeper := createTestApp(t, false)
 
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/exported"
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+// iteratePage calls the handler for at most limit records of the prefix starting from the cursor.
+// It returns the cursor of the next page or nil for the last page.
+func iteratePage(store sdk.KVStore, prefix, cursor []byte, limit int, handler func(key, value []byte)) []byte {
+	start := make([]byte, 0, len(prefix)+len(cursor))
+	start = append(append(start, prefix...), cursor...)
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	for count := 0; iterator.Valid(); iterator.Next() {
+		if count == limit {
+			return iterator.Key()[len(prefix):]
+		}
+		handler(iterator.Key(), iterator.Value())
+		count++
+	}
+	return nil
+}
+
+// GetCollectionNFTsPage returns a page of NFTs of the collection
+func (k Keeper) GetCollectionNFTsPage(ctx sdk.Context, denom string, cursor []byte, limit int) (nfts []exported.NFT, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	next = iteratePage(store, types.GetNFTsKey(denom), cursor, limit, func(_, value []byte) {
+		var nft exported.NFT
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &nft)
+		nfts = append(nfts, nft)
+	})
+
+	for i, nft := range nfts {
+		nfts[i] = nft.SetOwners(k.getNFTOwners(ctx, denom, nft.GetID()))
+	}
+	return nfts, next
+}
+
+// GetHeldSubTokensPage returns a page of sub-tokens held by the owner across all collections
+func (k Keeper) GetHeldSubTokensPage(ctx sdk.Context, owner sdk.AccAddress, cursor []byte, limit int) (subTokens []types.HeldSubTokens, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	var tokenIDs []types.TokenId
+	next = iteratePage(store, types.GetHeldNFTsKey(owner), cursor, limit, func(_, value []byte) {
+		var tokenID types.TokenId
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &tokenID)
+		tokenIDs = append(tokenIDs, tokenID)
+	})
+
+	for _, tokenID := range tokenIDs {
+		var tokenOwner exported.TokenOwner
+		bz := store.Get(types.GetNFTOwnerKey(tokenID.CollectionDenom, tokenID.NftID, owner))
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tokenOwner)
+		subTokens = append(subTokens, types.HeldSubTokens{
+			Denom:       tokenID.CollectionDenom,
+			ID:          tokenID.NftID,
+			SubTokenIDs: tokenOwner.GetSubTokenIDs(),
+		})
+	}
+	return subTokens, next
+}
+
+// GetCreatedNFTsPage returns a page of NFTs created by the creator across all collections
+func (k Keeper) GetCreatedNFTsPage(ctx sdk.Context, creator sdk.AccAddress, cursor []byte, limit int) (tokenIDs []types.TokenId, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	next = iteratePage(store, types.GetCreatedNFTsKey(creator), cursor, limit, func(_, value []byte) {
+		var tokenID types.TokenId
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &tokenID)
+		tokenIDs = append(tokenIDs, tokenID)
+	})
+	return tokenIDs, next
+}
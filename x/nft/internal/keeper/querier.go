@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -14,13 +15,21 @@ import (
 
 // query endpoints supported by the NFT Querier
 const (
-	QuerySupply       = "supply"
-	QueryOwner        = "owner"
-	QueryOwnerByDenom = "ownerByDenom"
-	QueryCollection   = "collection"
-	QueryDenoms       = "denoms"
-	QueryNFT          = "nft"
-	QuerySubTokens    = "sub_tokens"
+	QuerySupply         = "supply"
+	QueryOwner          = "owner"
+	QueryOwnerByDenom   = "ownerByDenom"
+	QueryCollection     = "collection"
+	QueryDenoms         = "denoms"
+	QueryNFT            = "nft"
+	QuerySubTokens      = "sub_tokens"
+	QueryCollectionInfo = "collection_info"
+	QueryListing        = "listing"
+	QueryListings       = "listings"
+	QueryApprovals      = "approvals"
+	QueryOperators      = "operators"
+	QueryCollectionNFTs = "collection_nfts"
+	QueryHeldSubTokens  = "held_sub_tokens"
+	QueryCreatedNFTs    = "created_nfts"
 )
 
 // NewQuerier is the module level router for state queries
@@ -41,6 +50,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryNFT(ctx, path[1:], req, k)
 		case QuerySubTokens:
 			return querySubTokens(ctx, req, k)
+		case QueryCollectionInfo:
+			return queryCollectionInfo(ctx, req, k)
+		case QueryListing:
+			return queryListing(ctx, req, k)
+		case QueryListings:
+			return queryListings(ctx, req, k)
+		case QueryApprovals:
+			return queryApprovals(ctx, req, k)
+		case QueryOperators:
+			return queryOperators(ctx, req, k)
+		case QueryCollectionNFTs:
+			return queryCollectionNFTs(ctx, req, k)
+		case QueryHeldSubTokens:
+			return queryHeldSubTokens(ctx, req, k)
+		case QueryCreatedNFTs:
+			return queryCreatedNFTs(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown nft query endpoint")
 		}
@@ -189,3 +214,217 @@ func querySubTokens(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 
 	return bz, nil
 }
+
+func queryCollectionInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCollectionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	info, found := k.GetCollectionInfo(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrUnknownCollection(params.Denom)
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(info)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryListing(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryListingParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	listing, found := k.GetListing(ctx, params.ID)
+	if !found {
+		return nil, types.ErrUnknownListing(params.ID)
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(listing)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryListings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryListingsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+	if len(params.Status) > 0 && !types.IsValidListingStatus(params.Status) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("unknown listing status: %s", params.Status))
+	}
+
+	var listings types.Listings
+	switch {
+	case len(params.Denom) > 0:
+		listings = k.GetListingsByDenom(ctx, params.Denom)
+	case !params.Seller.Empty():
+		listings = k.GetListingsBySeller(ctx, params.Seller)
+	default:
+		listings = k.GetListings(ctx)
+	}
+
+	filtered := make(types.Listings, 0, len(listings))
+	for _, listing := range listings {
+		if !params.Seller.Empty() && !listing.Seller.Equals(params.Seller) {
+			continue
+		}
+		if len(params.Status) > 0 && listing.Status != params.Status {
+			continue
+		}
+		filtered = append(filtered, listing)
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(filtered)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryApprovals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	if !k.IsNFT(ctx, params.Denom, params.TokenID) {
+		return nil, types.ErrUnknownNFT(params.Denom, params.TokenID)
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(k.GetNFTApprovals(ctx, params.Denom, params.TokenID))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryOperators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBalanceParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(k.GetOperators(ctx, params.Owner, params.Denom))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// parsePageParams parses params of paginated queries which are available only once NFTs are stored individually
+func parsePageParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) (params types.QueryPageParams, cursor []byte, err error) {
+	err = types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return params, nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	if !k.IsNFTStoreMigrated(ctx) {
+		return params, nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "paginated NFT queries are available after the NFT store migration")
+	}
+
+	cursor, err = hex.DecodeString(params.Cursor)
+	if err != nil {
+		return params, nil, types.ErrInvalidCursor(params.Cursor)
+	}
+
+	return params, cursor, nil
+}
+
+func queryCollectionNFTs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	params, cursor, err := parsePageParams(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasCollection(ctx, params.Denom) {
+		return nil, types.ErrUnknownCollection(params.Denom)
+	}
+
+	nfts, next := k.GetCollectionNFTsPage(ctx, params.Denom, cursor, params.PageLimit())
+	page := types.ResponseNFTsPage{
+		NFTs:       make([]types.ResponseNFT, len(nfts)),
+		NextCursor: hex.EncodeToString(next),
+	}
+	for i, nft := range nfts {
+		page.NFTs[i] = types.ResponseNFT{Denom: params.Denom, NFT: nft}
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(page)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryHeldSubTokens(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	params, cursor, err := parsePageParams(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+
+	subTokens, next := k.GetHeldSubTokensPage(ctx, params.Address, cursor, params.PageLimit())
+	page := types.ResponseHeldSubTokensPage{
+		SubTokens:  subTokens,
+		NextCursor: hex.EncodeToString(next),
+	}
+	if page.SubTokens == nil {
+		page.SubTokens = []types.HeldSubTokens{}
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(page)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryCreatedNFTs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	params, cursor, err := parsePageParams(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIDs, next := k.GetCreatedNFTsPage(ctx, params.Address, cursor, params.PageLimit())
+	page := types.ResponseNFTsPage{
+		NFTs:       make([]types.ResponseNFT, len(tokenIDs)),
+		NextCursor: hex.EncodeToString(next),
+	}
+	for i, tokenID := range tokenIDs {
+		nft, err := k.GetNFT(ctx, tokenID.CollectionDenom, tokenID.NftID)
+		if err != nil {
+			return nil, err
+		}
+		page.NFTs[i] = types.ResponseNFT{Denom: tokenID.CollectionDenom, NFT: nft}
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(page)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"bitbucket.org/decimalteam/go-node/utils/updates"
 	"bitbucket.org/decimalteam/go-node/x/nft/exported"
 	nftTypes "bitbucket.org/decimalteam/go-node/x/nft/internal/types"
 
@@ -309,3 +310,86 @@ func TestQueryDenoms(t *testing.T) {
 		require.Equal(t, denomInQuestion, denoms[key])
 	}
 }
+
+func TestQueryPages(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+	querier := NewQuerier(NFTKeeper)
+
+	query := func(path string, params nftTypes.QueryPageParams, out interface{}) error {
+		bz, err := nftTypes.ModuleCdc.MarshalJSON(params)
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{path}, abci.RequestQuery{Data: bz})
+		if err != nil {
+			return err
+		}
+		nftTypes.ModuleCdc.MustUnmarshalJSON(res, out)
+		return nil
+	}
+
+	// paginated queries are not available before the NFT store migration
+	var page nftTypes.ResponseNFTsPage
+	require.Error(t, query(QueryCollectionNFTs, nftTypes.NewQueryPageParams(Denom1, nil, "", 0), &page))
+
+	ctx = ctx.WithBlockHeight(updates.Update13Block)
+	NFTKeeper.MigrateNFTStore(ctx)
+	for i := 0; i < 5; i++ {
+		_, err := NFTKeeper.MintNFT(ctx, Denom1, strconv.Itoa(i), sdk.NewInt(100), sdk.NewInt(2), Addrs[0], Addrs[0], TokenURI1, true)
+		require.NoError(t, err)
+	}
+	_, err := NFTKeeper.MintNFT(ctx, Denom2, ID1, sdk.NewInt(100), sdk.NewInt(1), Addrs[1], Addrs[0], TokenURI2, true)
+	require.NoError(t, err)
+
+	// sub-tokens moved away from the holder remove the NFT from its held NFTs
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, "0")
+	require.NoError(t, err)
+	nft, err = nftTypes.TransferNFT(nft, Addrs[0], Addrs[1], []int64{1, 2})
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.UpdateNFT(ctx, Denom1, nft))
+
+	// pages of NFTs of a collection follow each other without gaps
+	ids := make(map[string]bool)
+	cursor := ""
+	for pages := 0; ; pages++ {
+		require.NoError(t, query(QueryCollectionNFTs, nftTypes.NewQueryPageParams(Denom1, nil, cursor, 2), &page))
+		for _, item := range page.NFTs {
+			require.Equal(t, Denom1, item.Denom)
+			ids[item.NFT.GetID()] = true
+		}
+		cursor = page.NextCursor
+		if cursor == "" {
+			require.Equal(t, 2, pages)
+			break
+		}
+		require.Len(t, page.NFTs, 2)
+	}
+	require.Len(t, ids, 5)
+
+	var held nftTypes.ResponseHeldSubTokensPage
+	require.NoError(t, query(QueryHeldSubTokens, nftTypes.NewQueryPageParams("", Addrs[0], "", 0), &held))
+	require.Len(t, held.SubTokens, 5)
+	require.Empty(t, held.NextCursor)
+	for _, subTokens := range held.SubTokens {
+		require.NotEqual(t, "0", subTokens.ID)
+	}
+	require.NoError(t, query(QueryHeldSubTokens, nftTypes.NewQueryPageParams("", Addrs[1], "", 0), &held))
+	require.Equal(t, []nftTypes.HeldSubTokens{{Denom: Denom1, ID: "0", SubTokenIDs: []int64{1, 2}}}, held.SubTokens)
+
+	require.NoError(t, query(QueryCreatedNFTs, nftTypes.NewQueryPageParams("", Addrs[0], "", 3), &page))
+	require.Len(t, page.NFTs, 3)
+	require.NotEmpty(t, page.NextCursor)
+	require.NoError(t, query(QueryCreatedNFTs, nftTypes.NewQueryPageParams("", Addrs[0], page.NextCursor, 3), &page))
+	require.Len(t, page.NFTs, 2)
+	require.Empty(t, page.NextCursor)
+	require.NoError(t, query(QueryCreatedNFTs, nftTypes.NewQueryPageParams("", Addrs[1], "", 0), &page))
+	require.Len(t, page.NFTs, 1)
+	require.Equal(t, Denom2, page.NFTs[0].Denom)
+
+	require.Error(t, query(QueryCollectionNFTs, nftTypes.NewQueryPageParams(Denom1, nil, "zz", 0), &page))
+	require.Error(t, query(QueryCollectionNFTs, nftTypes.NewQueryPageParams(Denom3, nil, "", 0), &page))
+
+	// whole collections are still queried by iterating over their NFTs
+	bz, err := nftTypes.ModuleCdc.MarshalJSON(nftTypes.NewQueryCollectionParams(Denom1))
+	require.NoError(t, err)
+	_, err = querier(ctx, []string{QueryCollection}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/exported"
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
+)
+
+// SetNFTRoyalty sets the royalty of a newly minted NFT. The royalty can not be changed once it is set.
+func (k Keeper) SetNFTRoyalty(ctx sdk.Context, denom, id string, recipient sdk.AccAddress, basisPoints uint64) error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	if !nft.GetRoyaltyRecipient().Empty() {
+		return types.ErrRoyaltyImmutable(denom, id)
+	}
+
+	return k.UpdateNFT(ctx, denom, nft.SetRoyalty(recipient, basisPoints))
+}
+
+// SetNFTTransferRestrictions sets the transfer restrictions of a newly minted NFT.
+// The restrictions can not be changed once they are set.
+func (k Keeper) SetNFTTransferRestrictions(ctx sdk.Context, denom, id string, nonTransferable bool, lockedUntil int64) error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	if nft.IsNonTransferable() || nft.GetTransferLockedUntil() != 0 {
+		return types.ErrTransferRestrictionsImmutable(denom, id)
+	}
+
+	return k.UpdateNFT(ctx, denom, nft.SetTransferRestrictions(nonTransferable, lockedUntil))
+}
+
+// PayRoyalty pays the royalty of the NFT from the price to the royalty recipient and returns the paid amount.
+// Nothing is paid when the payer is the royalty recipient itself.
+func (k Keeper) PayRoyalty(ctx sdk.Context, nft exported.NFT, payer sdk.AccAddress, price sdk.Coin) (sdk.Coin, error) {
+	royalty := types.CalculateRoyalty(nft, price)
+	if royalty.IsZero() || payer.Equals(nft.GetRoyaltyRecipient()) {
+		return sdk.NewCoin(price.Denom, sdk.ZeroInt()), nil
+	}
+
+	err := k.bankKeeper.SendCoins(ctx, payer, nft.GetRoyaltyRecipient(), sdk.NewCoins(royalty))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return royalty, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetNFTRoyalty(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+
+	err = NFTKeeper.SetNFTRoyalty(ctx, Denom1, ID1, Addrs[2], 250)
+	require.NoError(t, err)
+
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[2], nft.GetRoyaltyRecipient())
+	require.Equal(t, uint64(250), nft.GetRoyaltyBasisPoints())
+
+	// the royalty is immutable
+	err = NFTKeeper.SetNFTRoyalty(ctx, Denom1, ID1, Addrs[1], 100)
+	require.Error(t, err)
+
+	// minting more sub-tokens keeps the royalty
+	_, err = NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	nft, err = NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(250), nft.GetRoyaltyBasisPoints())
+}
+
+func TestPayRoyalty(t *testing.T) {
+	ctx, _, NFTKeeper := createTestApp(t, false)
+
+	_, err := NFTKeeper.MintNFT(ctx, Denom1, ID1, sdk.NewInt(100), sdk.NewInt(1), Addrs[0], Addrs[0], TokenURI1, true)
+	require.NoError(t, err)
+	require.NoError(t, NFTKeeper.SetNFTRoyalty(ctx, Denom1, ID1, Addrs[2], 250))
+	nft, err := NFTKeeper.GetNFT(ctx, Denom1, ID1)
+	require.NoError(t, err)
+
+	price := sdk.NewCoin(DefaultBondDenom, sdk.NewInt(10000))
+	balanceBefore := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[2]).AmountOf(DefaultBondDenom)
+
+	royalty, err := NFTKeeper.PayRoyalty(ctx, nft, Addrs[0], price)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(DefaultBondDenom, sdk.NewInt(250)), royalty)
+
+	balanceAfter := NFTKeeper.bankKeeper.GetCoins(ctx, Addrs[2]).AmountOf(DefaultBondDenom)
+	require.Equal(t, balanceBefore.AddRaw(250), balanceAfter)
+
+	// the royalty recipient does not pay to itself
+	royalty, err = NFTKeeper.PayRoyalty(ctx, nft, Addrs[2], price)
+	require.NoError(t, err)
+	require.True(t, royalty.IsZero())
+
+	// the payer must be able to pay the royalty
+	_, err = NFTKeeper.PayRoyalty(ctx, nft, Addrs[0], sdk.NewCoin(DefaultBondDenom, sdk.NewInt(1).MulRaw(1e18)))
+	require.Error(t, err)
+}
@@ -35,7 +35,7 @@ const (
 // Hogpodge of all sorts of input required for testing.
 // `initPower` is converted to an amount of tokens.
 // If `initPower` is 0, no addrs get created.
-func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context, Keeper) {
+func CreateTestInput(t testing.TB, isCheckTx bool, initPower int64) (sdk.Context, Keeper) {
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
@@ -93,6 +93,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName: nil,
 		nftTypes.ReservedPool: {supply.Burner},
+		nftTypes.MarketPool:   nil,
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bk, maccPerms)
 
@@ -104,7 +105,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		Symbol: coinConfig.SymbolBaseCoin,
 		Volume: coinConfig.InitialVolumeBaseCoin,
 	})
-	nftkeeper := NewKeeper(cdc, keyCoin, supplyKeeper, DefaultBondDenom)
+	nftkeeper := NewKeeper(cdc, keyCoin, supplyKeeper, bk, DefaultBondDenom)
 
 	totalSupply := sdk.NewCoins(sdk.NewCoin(DefaultBondDenom, initTokens.MulRaw(int64(len(nftTypes.Addrs)))))
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
@@ -139,6 +140,16 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(nftTypes.MsgEditNFTMetadata{}, "nft/msg_edit_metadata", nil)
 	cdc.RegisterConcrete(nftTypes.MsgMintNFT{}, "nft/msg_mint", nil)
 	cdc.RegisterConcrete(nftTypes.MsgBurnNFT{}, "nft/msg_burn", nil)
+	cdc.RegisterConcrete(nftTypes.MsgTransferCollectionOwnership{}, "nft/msg_transfer_collection_ownership", nil)
+	cdc.RegisterConcrete(nftTypes.MsgEditCollectionMetadata{}, "nft/msg_edit_collection_metadata", nil)
+	cdc.RegisterConcrete(nftTypes.MsgSetCollectionMinter{}, "nft/msg_set_collection_minter", nil)
+	cdc.RegisterConcrete(nftTypes.MsgListNFT{}, "nft/msg_list", nil)
+	cdc.RegisterConcrete(nftTypes.MsgCreateAuction{}, "nft/msg_create_auction", nil)
+	cdc.RegisterConcrete(nftTypes.MsgCancelListing{}, "nft/msg_cancel_listing", nil)
+	cdc.RegisterConcrete(nftTypes.MsgBuyNFT{}, "nft/msg_buy", nil)
+	cdc.RegisterConcrete(nftTypes.MsgBidNFT{}, "nft/msg_bid", nil)
+	cdc.RegisterConcrete(nftTypes.MsgApproveNFT{}, "nft/msg_approve", nil)
+	cdc.RegisterConcrete(nftTypes.MsgRevokeNFT{}, "nft/msg_revoke", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*exported2.Account)(nil), nil)
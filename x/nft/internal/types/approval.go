@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubTokenApproval allows the operator to transfer the sub-token on behalf of its owner.
+// The approval is cleared when the sub-token changes its owner.
+type SubTokenApproval struct {
+	Denom      string         `json:"denom" yaml:"denom"`
+	ID         string         `json:"id" yaml:"id"`
+	SubTokenID int64          `json:"sub_token_id" yaml:"sub_token_id"`
+	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator   sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+// NewSubTokenApproval creates a new SubTokenApproval
+func NewSubTokenApproval(denom, id string, subTokenID int64, owner, operator sdk.AccAddress) SubTokenApproval {
+	return SubTokenApproval{
+		Denom:      denom,
+		ID:         id,
+		SubTokenID: subTokenID,
+		Owner:      owner,
+		Operator:   operator,
+	}
+}
+
+// String follows stringer interface
+func (a SubTokenApproval) String() string {
+	return fmt.Sprintf(`Denom:				%s
+ID:				%s
+Sub-token ID:			%d
+Owner:				%s
+Operator:			%s`,
+		a.Denom,
+		a.ID,
+		a.SubTokenID,
+		a.Owner,
+		a.Operator,
+	)
+}
+
+// SubTokenApprovals is a set of sub-token approvals
+type SubTokenApprovals []SubTokenApproval
+
+// String follows stringer interface
+func (approvals SubTokenApprovals) String() string {
+	out := make([]string, len(approvals))
+	for i, approval := range approvals {
+		out[i] = approval.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// OperatorApproval allows the operator to transfer all the sub-tokens of the collection held by the owner
+type OperatorApproval struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+// NewOperatorApproval creates a new OperatorApproval
+func NewOperatorApproval(owner sdk.AccAddress, denom string, operator sdk.AccAddress) OperatorApproval {
+	return OperatorApproval{
+		Owner:    owner,
+		Denom:    denom,
+		Operator: operator,
+	}
+}
+
+// String follows stringer interface
+func (a OperatorApproval) String() string {
+	return fmt.Sprintf(`Owner:				%s
+Denom:				%s
+Operator:			%s`,
+		a.Owner,
+		a.Denom,
+		a.Operator,
+	)
+}
+
+// OperatorApprovals is a set of operator approvals
+type OperatorApprovals []OperatorApproval
+
+// String follows stringer interface
+func (approvals OperatorApprovals) String() string {
+	out := make([]string, len(approvals))
+	for i, approval := range approvals {
+		out[i] = approval.String()
+	}
+	return strings.Join(out, "\n")
+}
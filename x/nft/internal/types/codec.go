@@ -24,6 +24,16 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMintNFT{}, "nft/msg_mint", nil)
 	cdc.RegisterConcrete(MsgBurnNFT{}, "nft/msg_burn", nil)
 	cdc.RegisterConcrete(MsgUpdateReserveNFT{}, "nft/update_reserve", nil)
+	cdc.RegisterConcrete(MsgTransferCollectionOwnership{}, "nft/msg_transfer_collection_ownership", nil)
+	cdc.RegisterConcrete(MsgEditCollectionMetadata{}, "nft/msg_edit_collection_metadata", nil)
+	cdc.RegisterConcrete(MsgSetCollectionMinter{}, "nft/msg_set_collection_minter", nil)
+	cdc.RegisterConcrete(MsgListNFT{}, "nft/msg_list", nil)
+	cdc.RegisterConcrete(MsgCreateAuction{}, "nft/msg_create_auction", nil)
+	cdc.RegisterConcrete(MsgCancelListing{}, "nft/msg_cancel_listing", nil)
+	cdc.RegisterConcrete(MsgBuyNFT{}, "nft/msg_buy", nil)
+	cdc.RegisterConcrete(MsgBidNFT{}, "nft/msg_bid", nil)
+	cdc.RegisterConcrete(MsgApproveNFT{}, "nft/msg_approve", nil)
+	cdc.RegisterConcrete(MsgRevokeNFT{}, "nft/msg_revoke", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
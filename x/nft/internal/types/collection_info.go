@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CollectionInfo holds the ownership and collection-level metadata of a denom
+type CollectionInfo struct {
+	Denom       string           `json:"denom" yaml:"denom"`
+	Creator     sdk.AccAddress   `json:"creator" yaml:"creator"`
+	Owner       sdk.AccAddress   `json:"owner" yaml:"owner"`
+	Name        string           `json:"name,omitempty" yaml:"name"`
+	Description string           `json:"description,omitempty" yaml:"description"`
+	URI         string           `json:"uri,omitempty" yaml:"uri"`
+	Minters     []sdk.AccAddress `json:"minters,omitempty" yaml:"minters"` // addresses allowed to mint besides the owner
+}
+
+// NewCollectionInfo creates a new CollectionInfo owned by its creator
+func NewCollectionInfo(denom string, creator sdk.AccAddress) CollectionInfo {
+	return CollectionInfo{
+		Denom:   strings.TrimSpace(denom),
+		Creator: creator,
+		Owner:   creator,
+	}
+}
+
+// MinterIndex returns the index of the address in the allow-list or -1 if it is absent
+func (info CollectionInfo) MinterIndex(address sdk.AccAddress) int {
+	for i, minter := range info.Minters {
+		if minter.Equals(address) {
+			return i
+		}
+	}
+	return -1
+}
+
+// CanMint returns whether the address is allowed to mint into the collection
+func (info CollectionInfo) CanMint(address sdk.AccAddress) bool {
+	return info.Owner.Equals(address) || info.MinterIndex(address) != -1
+}
+
+// SetMinter adds the address to the allow-list or removes it from there
+func (info CollectionInfo) SetMinter(address sdk.AccAddress, allowed bool) CollectionInfo {
+	index := info.MinterIndex(address)
+	switch {
+	case allowed && index == -1:
+		info.Minters = append(info.Minters, address)
+	case !allowed && index != -1:
+		minters := make([]sdk.AccAddress, 0, len(info.Minters)-1)
+		minters = append(minters, info.Minters[:index]...)
+		info.Minters = append(minters, info.Minters[index+1:]...)
+	}
+	return info
+}
+
+// String follows stringer interface
+func (info CollectionInfo) String() string {
+	minters := make([]string, len(info.Minters))
+	for i, minter := range info.Minters {
+		minters[i] = minter.String()
+	}
+	return fmt.Sprintf(`Denom: 				%s
+Creator:			%s
+Owner:				%s
+Name:				%s
+Description:			%s
+URI:				%s
+Minters:			%s`,
+		info.Denom,
+		info.Creator,
+		info.Owner,
+		info.Name,
+		info.Description,
+		info.URI,
+		strings.Join(minters, ","),
+	)
+}
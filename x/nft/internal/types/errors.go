@@ -4,6 +4,7 @@ import (
 	"bitbucket.org/decimalteam/go-node/utils/errors"
 	"fmt"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"strconv"
 )
 
 type CodeType = uint32
@@ -34,6 +35,28 @@ const (
 	CodeNotAllowedUpdateNFTReserve    CodeType = 120
 	CodeNotSetValueLowerNow           CodeType = 121
 	CodeNotEnoughFunds                CodeType = 122
+	CodeNotCollectionOwner            CodeType = 123
+	CodeNotAllowedCollectionMint      CodeType = 124
+	CodeInvalidCollectionMetadata     CodeType = 125
+	CodeInvalidRoyalty                CodeType = 126
+	CodeRoyaltyImmutable              CodeType = 127
+	CodeUnknownListing                CodeType = 128
+	CodeListingNotActive              CodeType = 129
+	CodeNotListingSeller              CodeType = 130
+	CodeInvalidListingType            CodeType = 131
+	CodeInvalidPrice                  CodeType = 132
+	CodeInvalidEndHeight              CodeType = 133
+	CodeBidTooLow                     CodeType = 134
+	CodeAuctionHasBids                CodeType = 135
+	CodeAuctionEnded                  CodeType = 136
+	CodeSellerCannotBuy               CodeType = 137
+	CodeNotApproved                   CodeType = 138
+	CodeInvalidOperator               CodeType = 139
+	CodeInvalidCursor                 CodeType = 140
+	CodeInvalidTransferLock           CodeType = 141
+	CodeTransferRestrictionsImmutable CodeType = 142
+	CodeNFTNotTransferable            CodeType = 143
+	CodeApprovalsNotEnabled           CodeType = 144
 )
 
 func ErrInvalidCollection(denom string) *sdkerrors.Error {
@@ -226,3 +249,214 @@ func ErrNotSetValueLowerNow() *sdkerrors.Error {
 		"Invalid new reserve",
 	)
 }
+
+func ErrNotCollectionOwner(denom string, sender string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeNotCollectionOwner,
+		fmt.Sprintf("%s is not the owner of NFT collection %s", sender, denom),
+		errors.NewParam("denom", denom),
+		errors.NewParam("sender", sender),
+	)
+}
+
+func ErrNotAllowedCollectionMint(denom string, sender string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeNotAllowedCollectionMint,
+		fmt.Sprintf("%s is not allowed to mint into NFT collection %s", sender, denom),
+		errors.NewParam("denom", denom),
+		errors.NewParam("sender", sender),
+	)
+}
+
+func ErrInvalidCollectionMetadata(field string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidCollectionMetadata,
+		fmt.Sprintf("invalid collection metadata: %s is too long", field),
+		errors.NewParam("field", field),
+	)
+}
+
+func ErrInvalidRoyalty(basisPoints uint64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidRoyalty,
+		fmt.Sprintf("invalid royalty: %d basis points, royalty must have a recipient and be at most %d basis points", basisPoints, MaxRoyaltyBasisPoints),
+		errors.NewParam("basis_points", strconv.FormatUint(basisPoints, 10)),
+	)
+}
+
+func ErrRoyaltyImmutable(denom, id string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeRoyaltyImmutable,
+		fmt.Sprintf("royalty of NFT %s/%s can be set only on the first mint", denom, id),
+		errors.NewParam("denom", denom),
+		errors.NewParam("id", id),
+	)
+}
+
+func ErrUnknownListing(id uint64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeUnknownListing,
+		fmt.Sprintf("unknown NFT listing: %d", id),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+	)
+}
+
+func ErrListingNotActive(id uint64, status string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeListingNotActive,
+		fmt.Sprintf("NFT listing %d is not active: %s", id, status),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+		errors.NewParam("status", status),
+	)
+}
+
+func ErrNotListingSeller(id uint64, sender string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeNotListingSeller,
+		fmt.Sprintf("%s is not the seller of NFT listing %d", sender, id),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+		errors.NewParam("sender", sender),
+	)
+}
+
+func ErrInvalidListingType(id uint64, listingType string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidListingType,
+		fmt.Sprintf("operation is not supported by NFT listing %d of type %s", id, listingType),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+		errors.NewParam("type", listingType),
+	)
+}
+
+func ErrInvalidPrice(price string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidPrice,
+		fmt.Sprintf("invalid price: %s", price),
+		errors.NewParam("price", price),
+	)
+}
+
+func ErrInvalidEndHeight(endHeight int64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidEndHeight,
+		fmt.Sprintf("invalid auction end height: %d", endHeight),
+		errors.NewParam("end_height", strconv.FormatInt(endHeight, 10)),
+	)
+}
+
+func ErrBidTooLow(bid, minBid string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeBidTooLow,
+		fmt.Sprintf("bid %s is too low, the minimal bid is %s", bid, minBid),
+		errors.NewParam("bid", bid),
+		errors.NewParam("min_bid", minBid),
+	)
+}
+
+func ErrAuctionHasBids(id uint64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeAuctionHasBids,
+		fmt.Sprintf("NFT auction %d can not be cancelled after the first bid", id),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+	)
+}
+
+func ErrAuctionEnded(id uint64, endHeight int64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeAuctionEnded,
+		fmt.Sprintf("NFT auction %d ended at height %d", id, endHeight),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+		errors.NewParam("end_height", strconv.FormatInt(endHeight, 10)),
+	)
+}
+
+func ErrSellerCannotBuy(id uint64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeSellerCannotBuy,
+		fmt.Sprintf("seller can not buy or bid on its own NFT listing %d", id),
+		errors.NewParam("id", strconv.FormatUint(id, 10)),
+	)
+}
+
+func ErrNotApproved(operator, owner, denom, id string, subTokenID int64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeNotApproved,
+		fmt.Sprintf("%s is not approved by %s to transfer sub-token %d of NFT %s/%s", operator, owner, subTokenID, denom, id),
+		errors.NewParam("operator", operator),
+		errors.NewParam("owner", owner),
+		errors.NewParam("denom", denom),
+		errors.NewParam("id", id),
+		errors.NewParam("sub_token_id", strconv.FormatInt(subTokenID, 10)),
+	)
+}
+
+func ErrInvalidOperator(operator string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidOperator,
+		fmt.Sprintf("invalid NFT operator: %s", operator),
+		errors.NewParam("operator", operator),
+	)
+}
+
+func ErrInvalidCursor(cursor string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidCursor,
+		fmt.Sprintf("invalid page cursor: %s", cursor),
+		errors.NewParam("cursor", cursor),
+	)
+}
+
+func ErrInvalidTransferLock(lockedUntil int64) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeInvalidTransferLock,
+		fmt.Sprintf("invalid transfer lock height: %d", lockedUntil),
+		errors.NewParam("transfer_locked_until", strconv.FormatInt(lockedUntil, 10)),
+	)
+}
+
+func ErrTransferRestrictionsImmutable(denom, id string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeTransferRestrictionsImmutable,
+		fmt.Sprintf("transfer restrictions of NFT %s/%s can be set only on the first mint", denom, id),
+		errors.NewParam("denom", denom),
+		errors.NewParam("id", id),
+	)
+}
+
+func ErrNFTNotTransferable(denom, id string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeNFTNotTransferable,
+		fmt.Sprintf("NFT %s/%s is not transferable", denom, id),
+		errors.NewParam("denom", denom),
+		errors.NewParam("id", id),
+	)
+}
+
+func ErrApprovalsNotEnabled() *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeApprovalsNotEnabled,
+		"approvals are not enabled until the NFT store is migrated",
+	)
+}
@@ -8,6 +8,20 @@ var (
 	EventTypeBurnNFT          = "burn_nft"
 	EventTypeUpdateReserveNFT = "update_nft_reserve"
 
+	EventTypeTransferCollectionOwnership = "transfer_collection_ownership"
+	EventTypeEditCollectionMetadata      = "edit_collection_metadata"
+	EventTypeSetCollectionMinter         = "set_collection_minter"
+
+	EventTypeListNFT       = "list_nft"
+	EventTypeCreateAuction = "create_nft_auction"
+	EventTypeCancelListing = "cancel_nft_listing"
+	EventTypeBuyNFT        = "buy_nft"
+	EventTypeBidNFT        = "bid_nft"
+	EventTypeCloseAuction  = "close_nft_auction"
+
+	EventTypeApproveNFT = "approve_nft"
+	EventTypeRevokeNFT  = "revoke_nft"
+
 	AttributeValueCategory = ModuleName
 
 	AttributeKeySender               = "sender"
@@ -17,4 +31,21 @@ var (
 	AttributeKeyNFTTokenURI          = "token_uri"
 	AttributeKeyDenom                = "denom"
 	AttributeKeySubTokenIDStartRange = "sub_token_id_start_range"
+	AttributeKeyMinter               = "minter"
+	AttributeKeySubTokenIDs          = "sub_token_ids"
+	AttributeKeyReserve              = "reserve"
+	AttributeKeyPrice                = "price"
+	AttributeKeyRoyaltyRecipient     = "royalty_recipient"
+	AttributeKeyRoyaltyBasisPoints   = "royalty_basis_points"
+	AttributeKeyRoyalty              = "royalty"
+	AttributeKeyTransferable         = "transferable"
+	AttributeKeyTransferLockedUntil  = "transfer_locked_until"
+	AttributeKeyAllowed              = "allowed"
+	AttributeKeyListingID            = "listing_id"
+	AttributeKeySeller               = "seller"
+	AttributeKeyBuyer                = "buyer"
+	AttributeKeyEndHeight            = "end_height"
+	AttributeKeyBid                  = "bid"
+	AttributeKeyStatus               = "status"
+	AttributeKeyOperator             = "operator"
 )
@@ -1,32 +1,48 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Owners          []Owner          `json:"owners"`
-	Collections     Collections      `json:"collections"`
-	SubTokens       []SubToken       `json:"sub_tokens"`
-	LastSubTokenIds []LastSubTokenId `json:"last_sub_token_ids"`
-	TokenIds        []TokenId        `json:"token_ids"`
+	Owners            []Owner           `json:"owners"`
+	Collections       Collections       `json:"collections"`
+	SubTokens         []SubToken        `json:"sub_tokens"`
+	LastSubTokenIds   []LastSubTokenId  `json:"last_sub_token_ids"`
+	TokenIds          []TokenId         `json:"token_ids"`
+	CollectionInfos   []CollectionInfo  `json:"collection_infos"`
+	Listings          Listings          `json:"listings"`
+	SubTokenApprovals SubTokenApprovals `json:"sub_token_approvals"`
+	OperatorApprovals OperatorApprovals `json:"operator_approvals"`
+	StoreMigrated     bool              `json:"store_migrated,omitempty"` // NFTs are stored individually, false in genesis exported before the NFT store migration
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(owners []Owner, collections Collections, subTokens []SubToken, lastSubTokensIds []LastSubTokenId, tokenIds []TokenId) GenesisState {
+func NewGenesisState(owners []Owner, collections Collections, subTokens []SubToken, lastSubTokensIds []LastSubTokenId, tokenIds []TokenId, collectionInfos []CollectionInfo, listings Listings,
+	subTokenApprovals SubTokenApprovals, operatorApprovals OperatorApprovals) GenesisState {
 	return GenesisState{
-		Owners:          owners,
-		Collections:     collections,
-		SubTokens:       subTokens,
-		LastSubTokenIds: lastSubTokensIds,
-		TokenIds:        tokenIds,
+		Owners:            owners,
+		Collections:       collections,
+		SubTokens:         subTokens,
+		LastSubTokenIds:   lastSubTokensIds,
+		TokenIds:          tokenIds,
+		CollectionInfos:   collectionInfos,
+		Listings:          listings,
+		SubTokenApprovals: subTokenApprovals,
+		OperatorApprovals: operatorApprovals,
 	}
 }
 
-// DefaultGenesisState returns a default genesis state
+// DefaultGenesisState returns a default genesis state. New chains store NFTs individually from the start.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState([]Owner{}, NewCollections(), []SubToken{}, []LastSubTokenId{}, []TokenId{})
+	genesisState := NewGenesisState([]Owner{}, NewCollections(), []SubToken{}, []LastSubTokenId{}, []TokenId{}, []CollectionInfo{}, Listings{},
+		SubTokenApprovals{}, OperatorApprovals{})
+	genesisState.StoreMigrated = true
+	return genesisState
 }
 
 // ValidateGenesis performs basic validation of nfts genesis data returning an
@@ -37,5 +53,137 @@ func ValidateGenesis(data GenesisState) error {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
 		}
 	}
+	for _, info := range data.CollectionInfos {
+		if info.Owner.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "collection owner cannot be empty")
+		}
+	}
+	for _, listing := range data.Listings {
+		if listing.Seller.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "listing seller cannot be empty")
+		}
+		if !IsValidListingStatus(listing.Status) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown status of listing %d: %s", listing.ID, listing.Status)
+		}
+	}
+	for _, approval := range data.SubTokenApprovals {
+		if approval.Owner.Empty() || approval.Operator.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sub-token approval owner and operator cannot be empty")
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if approval.Owner.Empty() || approval.Operator.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator approval owner and operator cannot be empty")
+		}
+	}
+	if err := validateGenesisOwners(data); err != nil {
+		return err
+	}
+	return validateGenesisSubTokens(data)
+}
+
+// validateGenesisOwners checks that the owner index lists exactly the NFTs of collections under their creators
+func validateGenesisOwners(data GenesisState) error {
+	creators := make(map[string]string)
+	for _, collection := range data.Collections {
+		for _, nft := range collection.NFTs {
+			creators[collection.Denom+"/"+nft.GetID()] = nft.GetCreator().String()
+		}
+	}
+
+	indexed := make(map[string]bool)
+	for _, owner := range data.Owners {
+		for _, idCollection := range owner.IDCollections {
+			for _, id := range idCollection.IDs {
+				key := idCollection.Denom + "/" + id
+				creator, found := creators[key]
+				if !found {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "owner %s indexes unknown NFT %s", owner.Address, key)
+				}
+				if creator != owner.Address.String() {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "owner %s indexes NFT %s created by %s", owner.Address, key, creator)
+				}
+				indexed[key] = true
+			}
+		}
+	}
+
+	for key := range creators {
+		if !indexed[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "NFT %s is missing in the owner index", key)
+		}
+	}
+	return nil
+}
+
+// validateGenesisSubTokens checks that sub-tokens and token IDs refer to NFTs of collections
+// and that all the sub-tokens held by owners have reserves. Delegated sub-tokens are not held
+// by owners but keep their reserves.
+func validateGenesisSubTokens(data GenesisState) error {
+	held := make(map[string]bool)
+	nfts := make(map[string]bool)
+	lastSubTokenIDs := make(map[string]int64)
+	for _, collection := range data.Collections {
+		for _, nft := range collection.NFTs {
+			nfts[collection.Denom+"/"+nft.GetID()] = true
+			for _, owner := range nft.GetOwners().GetOwners() {
+				for _, subTokenID := range owner.GetSubTokenIDs() {
+					held[subTokenKey(collection.Denom, nft.GetID(), subTokenID)] = true
+				}
+			}
+		}
+	}
+
+	for _, last := range data.LastSubTokenIds {
+		key := last.CollectionDenom + "/" + last.NftID
+		if !nfts[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "last sub-token ID of unknown NFT %s", key)
+		}
+		lastSubTokenIDs[key] = last.LastTokenTokenID
+	}
+
+	reserves := make(map[string]bool)
+	for _, subToken := range data.SubTokens {
+		key := subTokenKey(subToken.CollectionDenom, subToken.NftID, subToken.TokenID)
+		if !nfts[subToken.CollectionDenom+"/"+subToken.NftID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sub-token %s of unknown NFT", key)
+		}
+		if subToken.TokenID >= lastSubTokenIDs[subToken.CollectionDenom+"/"+subToken.NftID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sub-token %s is not less than the last sub-token ID", key)
+		}
+		if subToken.Reserve.IsNil() || subToken.Reserve.IsNegative() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid reserve of sub-token %s", key)
+		}
+		if reserves[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate sub-token %s", key)
+		}
+		reserves[key] = true
+	}
+
+	for key := range held {
+		if !reserves[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sub-token %s has no reserve", key)
+		}
+	}
+
+	for _, tokenID := range data.TokenIds {
+		key := tokenID.CollectionDenom + "/" + tokenID.NftID
+		if !nfts[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token ID of unknown NFT %s", key)
+		}
+	}
 	return nil
 }
+
+func subTokenKey(denom, id string, subTokenID int64) string {
+	return fmt.Sprintf("%s/%s/%d", denom, id, subTokenID)
+}
+
+// TotalReserve returns the sum of reserves of all the genesis sub-tokens
+func (data GenesisState) TotalReserve() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, subToken := range data.SubTokens {
+		total = total.Add(subToken.Reserve)
+	}
+	return total
+}
@@ -25,9 +25,37 @@ const (
 
 // NFTs are stored as follow:
 //
-// - Colections: 0x00<denom_bytes_key> :<Collection>
+// - Colections: 0x00<denom_bytes_key> :<Collection> (before the NFT store migration)
 //
 // - Owners: 0x01<address_bytes_key><denom_bytes_key>: <Owner>
+//
+// - Collection infos: 0x06<denom_bytes_key>: <CollectionInfo>
+//
+// - Listings: 0x07<listing_id>: <Listing>
+//
+// - Listings by denom: 0x09<denom_bytes_key><listing_id>: <listing_id>
+//
+// - Listings by seller: 0x0A<address_bytes_key><listing_id>: <listing_id>
+//
+// - Active auctions queue: 0x0B<end_height><listing_id>: <listing_id>
+//
+// - Sub-token approvals: 0x0C<denom_bytes_key><id_bytes_key><sub_token_id>: <SubTokenApproval>
+//
+// - Operator approvals: 0x0D<address_bytes_key><denom_bytes_key><operator_address>: <OperatorApproval>
+//
+// Since the NFT store migration collections are not stored as a whole, so a transfer rewrites only the changed NFT:
+//
+// - Denoms: 0x0E<denom_bytes_key>: <denom>
+//
+// - NFTs: 0x0F<denom_bytes_key><id_bytes_key>: <NFT> (without owners)
+//
+// - NFT owners: 0x10<denom_bytes_key><id_bytes_key><address>: <TokenOwner>
+//
+// - Held NFTs: 0x11<address><denom_bytes_key><id_bytes_key>: <TokenId> (only NFTs with held sub-tokens)
+//
+// - Created NFTs: 0x12<address><denom_bytes_key><id_bytes_key>: <TokenId>
+//
+// - NFT store migrated: 0x13: 0x01 (set by the NFT store migration)
 
 const NFTPrefix = 0x60
 
@@ -38,6 +66,20 @@ var (
 	LastSubTokenIDKeyPrefix = []byte{NFTPrefix, 0x03}
 	TokenURIKeyPrefix       = []byte{NFTPrefix, 0x04}
 	TokenIDKeyPrefix        = []byte{NFTPrefix, 0x05}
+	CollectionInfoKeyPrefix = []byte{NFTPrefix, 0x06} // key for collection ownership and metadata
+	ListingKeyPrefix        = []byte{NFTPrefix, 0x07} // key for marketplace listings and auctions
+	LastListingIDKey        = []byte{NFTPrefix, 0x08}
+	DenomListingKeyPrefix   = []byte{NFTPrefix, 0x09}
+	SellerListingKeyPrefix  = []byte{NFTPrefix, 0x0A}
+	AuctionQueueKeyPrefix   = []byte{NFTPrefix, 0x0B}
+	SubTokenApprovalPrefix  = []byte{NFTPrefix, 0x0C} // key for approvals of single sub-tokens
+	OperatorApprovalPrefix  = []byte{NFTPrefix, 0x0D} // key for approvals of operators of a collection
+	DenomKeyPrefix          = []byte{NFTPrefix, 0x0E} // key for the index of collection denoms
+	NFTKeyPrefix            = []byte{NFTPrefix, 0x0F} // key for NFTs stored individually
+	NFTOwnerKeyPrefix       = []byte{NFTPrefix, 0x10} // key for owners of NFTs stored individually
+	HeldNFTKeyPrefix        = []byte{NFTPrefix, 0x11} // key for the index of NFTs by holders of their sub-tokens
+	CreatedNFTKeyPrefix     = []byte{NFTPrefix, 0x12} // key for the index of NFTs by creators
+	NFTStoreMigratedKey     = []byte{NFTPrefix, 0x13} // key for the flag set once NFTs are stored individually
 )
 
 const OwnerKeyHashLength = 54
@@ -49,6 +91,51 @@ func GetCollectionKey(denom string) []byte {
 	return append(CollectionsKeyPrefix, bs...)
 }
 
+// GetDenomKey gets the key of a collection in the denom index
+func GetDenomKey(denom string) []byte {
+	return append(DenomKeyPrefix, getHash(denom)...)
+}
+
+// GetNFTsKey gets the key prefix for all the NFTs of a collection
+func GetNFTsKey(denom string) []byte {
+	return append(NFTKeyPrefix, getHash(denom)...)
+}
+
+// GetNFTKey gets the key of an NFT
+func GetNFTKey(denom, id string) []byte {
+	return append(GetNFTsKey(denom), getHash(id)...)
+}
+
+// GetNFTOwnersKey gets the key prefix for all the owners of an NFT
+func GetNFTOwnersKey(denom, id string) []byte {
+	return append(append(NFTOwnerKeyPrefix, getHash(denom)...), getHash(id)...)
+}
+
+// GetNFTOwnerKey gets the key of an owner of an NFT
+func GetNFTOwnerKey(denom, id string, owner sdk.AccAddress) []byte {
+	return append(GetNFTOwnersKey(denom, id), owner.Bytes()...)
+}
+
+// GetHeldNFTsKey gets the key prefix for all the NFTs with sub-tokens held by an address
+func GetHeldNFTsKey(owner sdk.AccAddress) []byte {
+	return append(HeldNFTKeyPrefix, owner.Bytes()...)
+}
+
+// GetHeldNFTKey gets the key of an NFT with sub-tokens held by an address
+func GetHeldNFTKey(owner sdk.AccAddress, denom, id string) []byte {
+	return append(append(GetHeldNFTsKey(owner), getHash(denom)...), getHash(id)...)
+}
+
+// GetCreatedNFTsKey gets the key prefix for all the NFTs created by an address
+func GetCreatedNFTsKey(creator sdk.AccAddress) []byte {
+	return append(CreatedNFTKeyPrefix, creator.Bytes()...)
+}
+
+// GetCreatedNFTKey gets the key of an NFT created by an address
+func GetCreatedNFTKey(creator sdk.AccAddress, denom, id string) []byte {
+	return append(append(GetCreatedNFTsKey(creator), getHash(denom)...), getHash(id)...)
+}
+
 // SplitOwnerKey gets an address and denom from an owner key
 func SplitOwnerKey(key []byte) (sdk.AccAddress, []byte) {
 	if len(key) != OwnerKeyHashLength {
@@ -71,6 +158,70 @@ func GetOwnerKey(address sdk.AccAddress, denom string) []byte {
 	return append(GetOwnersKey(address), bs...)
 }
 
+// GetCollectionInfoKey gets the key of a collection info
+func GetCollectionInfoKey(denom string) []byte {
+	bs := getHash(denom)
+
+	return append(CollectionInfoKeyPrefix, bs...)
+}
+
+// GetListingKey gets the key of a listing
+func GetListingKey(id uint64) []byte {
+	return append(ListingKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDenomListingsKey gets the key prefix for all the listings of a collection
+func GetDenomListingsKey(denom string) []byte {
+	return append(DenomListingKeyPrefix, getHash(denom)...)
+}
+
+// GetDenomListingKey gets the key of a listing in the index by collection
+func GetDenomListingKey(denom string, id uint64) []byte {
+	return append(GetDenomListingsKey(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSellerListingsKey gets the key prefix for all the listings of a seller
+func GetSellerListingsKey(seller sdk.AccAddress) []byte {
+	return append(SellerListingKeyPrefix, seller.Bytes()...)
+}
+
+// GetSellerListingKey gets the key of a listing in the index by seller
+func GetSellerListingKey(seller sdk.AccAddress, id uint64) []byte {
+	return append(GetSellerListingsKey(seller), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuctionQueueKey gets the key of an active auction in the queue ordered by end height
+func GetAuctionQueueKey(endHeight int64, id uint64) []byte {
+	return append(append(AuctionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(endHeight))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuctionQueueEndKey gets the key bounding auctions ending at the height or earlier
+func GetAuctionQueueEndKey(endHeight int64) []byte {
+	return append(AuctionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(endHeight+1))...)
+}
+
+// GetNFTApprovalsKey gets the key prefix for all the sub-token approvals of an NFT
+func GetNFTApprovalsKey(denom, id string) []byte {
+	return append(append(SubTokenApprovalPrefix, getHash(denom)...), getHash(id)...)
+}
+
+// GetSubTokenApprovalKey gets the key of a sub-token approval
+func GetSubTokenApprovalKey(denom, id string, subTokenID int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(subTokenID))
+	return append(GetNFTApprovalsKey(denom, id), b...)
+}
+
+// GetOperatorApprovalsKey gets the key prefix for all the operators approved by the owner for a collection
+func GetOperatorApprovalsKey(owner sdk.AccAddress, denom string) []byte {
+	return append(append(OperatorApprovalPrefix, owner.Bytes()...), getHash(denom)...)
+}
+
+// GetOperatorApprovalKey gets the key of an operator approval
+func GetOperatorApprovalKey(owner sdk.AccAddress, denom string, operator sdk.AccAddress) []byte {
+	return append(GetOperatorApprovalsKey(owner, denom), operator.Bytes()...)
+}
+
 func GetSubTokenKey(denom, id string, subTokenID int64) []byte {
 	bs := getHash(denom)
 	bsID := getHash(id)
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Listing types
+const (
+	ListingTypeFixedPrice = "fixed_price"
+	ListingTypeAuction    = "auction"
+)
+
+// Listing statuses
+const (
+	ListingStatusActive    = "active"
+	ListingStatusSold      = "sold"
+	ListingStatusCancelled = "cancelled"
+	ListingStatusExpired   = "expired"
+	ListingStatusFailed    = "failed" // the auction failed to settle, the escrowed sub-tokens and bid are returned
+)
+
+// Listing is an offer to sell sub-tokens of an NFT at a fixed price or by an English auction.
+// Listed sub-tokens are held by the market pool until the listing is closed.
+type Listing struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Type        string         `json:"type" yaml:"type"`
+	Status      string         `json:"status" yaml:"status"`
+	Seller      sdk.AccAddress `json:"seller" yaml:"seller"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	NFTID       string         `json:"nft_id" yaml:"nft_id"`
+	SubTokenIDs []int64        `json:"sub_token_ids" yaml:"sub_token_ids"`
+	Price       sdk.Coin       `json:"price" yaml:"price"` // fixed price or reserve price of the auction
+	EndHeight   int64          `json:"end_height,omitempty" yaml:"end_height"`
+	Buyer       sdk.AccAddress `json:"buyer,omitempty" yaml:"buyer"` // buyer or the highest bidder of the auction
+	Bid         sdk.Coin       `json:"bid" yaml:"bid"`               // the highest bid of the auction
+}
+
+// NewFixedPriceListing creates a new listing selling sub-tokens at the price
+func NewFixedPriceListing(id uint64, seller sdk.AccAddress, denom, nftID string, subTokenIDs []int64, price sdk.Coin) Listing {
+	return Listing{
+		ID:          id,
+		Type:        ListingTypeFixedPrice,
+		Status:      ListingStatusActive,
+		Seller:      seller,
+		Denom:       denom,
+		NFTID:       nftID,
+		SubTokenIDs: subTokenIDs,
+		Price:       price,
+	}
+}
+
+// NewAuction creates a new English auction of sub-tokens ending at the height
+func NewAuction(id uint64, seller sdk.AccAddress, denom, nftID string, subTokenIDs []int64, reservePrice sdk.Coin, endHeight int64) Listing {
+	listing := NewFixedPriceListing(id, seller, denom, nftID, subTokenIDs, reservePrice)
+	listing.Type = ListingTypeAuction
+	listing.EndHeight = endHeight
+	return listing
+}
+
+// IsActive returns whether the listing is still open
+func (l Listing) IsActive() bool {
+	return l.Status == ListingStatusActive
+}
+
+// HasBid returns whether the auction got at least one bid
+func (l Listing) HasBid() bool {
+	return !l.Buyer.Empty()
+}
+
+// MinBid returns the minimal amount of the next bid.
+// A bid must be at least the reserve price and outbid the highest bid.
+func (l Listing) MinBid() sdk.Coin {
+	if l.HasBid() {
+		return sdk.NewCoin(l.Bid.Denom, l.Bid.Amount.AddRaw(1))
+	}
+	return l.Price
+}
+
+// String follows stringer interface
+func (l Listing) String() string {
+	return fmt.Sprintf(`ID:				%d
+Type:				%s
+Status:				%s
+Seller:				%s
+Denom:				%s
+NFT ID:				%s
+Sub-token IDs:			%v
+Price:				%s
+End height:			%d
+Buyer:				%s
+Bid:				%s`,
+		l.ID,
+		l.Type,
+		l.Status,
+		l.Seller,
+		l.Denom,
+		l.NFTID,
+		l.SubTokenIDs,
+		l.Price,
+		l.EndHeight,
+		l.Buyer,
+		l.Bid,
+	)
+}
+
+// IsValidListingStatus returns true if the status is known listing status
+func IsValidListingStatus(status string) bool {
+	switch status {
+	case ListingStatusActive, ListingStatusSold, ListingStatusCancelled, ListingStatusExpired, ListingStatusFailed:
+		return true
+	}
+	return false
+}
+
+// Listings is a set of listings
+type Listings []Listing
+
+// String follows stringer interface
+func (listings Listings) String() string {
+	out := make([]string, len(listings))
+	for i, listing := range listings {
+		out[i] = listing.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -22,6 +22,12 @@ type MsgMintNFT struct {
 	TokenURI  string         `json:"token_uri"`
 	Reserve   sdk.Int        `json:"reserve"`
 	AllowMint bool           `json:"allow_mint"`
+
+	RoyaltyRecipient   sdk.AccAddress `json:"royalty_recipient,omitempty"`
+	RoyaltyBasisPoints uint64         `json:"royalty_basis_points,omitempty"`
+
+	NonTransferable     bool  `json:"non_transferable,omitempty"`
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty"`
 }
 
 // NewMsgMintNFT is a constructor function for MsgMintNFT
@@ -38,8 +44,30 @@ func NewMsgMintNFT(sender, recipient sdk.AccAddress, id, denom, tokenURI string,
 	}
 }
 
+// WithRoyalty returns the message minting an NFT with the royalty paid to the recipient on sales
+func (msg MsgMintNFT) WithRoyalty(recipient sdk.AccAddress, basisPoints uint64) MsgMintNFT {
+	msg.RoyaltyRecipient = recipient
+	msg.RoyaltyBasisPoints = basisPoints
+	return msg
+}
+
+// WithTransferRestrictions returns the message minting an NFT which sub-tokens can not be transferred
+// by holders at all or before the height
+func (msg MsgMintNFT) WithTransferRestrictions(transferable bool, lockedUntil int64) MsgMintNFT {
+	msg.NonTransferable = !transferable
+	msg.TransferLockedUntil = lockedUntil
+	return msg
+}
+
 const regName = "^[a-zA-Z0-9_-]{1,255}$"
 
+const (
+	// BasisPointsDenominator is the number of basis points in the whole price
+	BasisPointsDenominator = 10000
+	// MaxRoyaltyBasisPoints is the maximum royalty of an NFT (50% of the price)
+	MaxRoyaltyBasisPoints = 5000
+)
+
 var MinReserve = sdk.NewInt(100)
 
 var NewMinReserve = helpers.BipToPip(sdk.NewInt(100))
@@ -78,6 +106,12 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if match, _ := regexp.MatchString(regName, msg.ID); !match {
 		return ErrInvalidTokenID(msg.ID)
 	}
+	if msg.RoyaltyRecipient.Empty() != (msg.RoyaltyBasisPoints == 0) || msg.RoyaltyBasisPoints > MaxRoyaltyBasisPoints {
+		return ErrInvalidRoyalty(msg.RoyaltyBasisPoints)
+	}
+	if msg.TransferLockedUntil < 0 {
+		return ErrInvalidTransferLock(msg.TransferLockedUntil)
+	}
 
 	return nil
 }
@@ -221,6 +255,8 @@ type MsgTransferNFT struct {
 	ID          string         `json:"id"`
 	Denom       string         `json:"denom"`
 	SubTokenIDs []int64        `json:"sub_token_ids"`
+	Price       *sdk.Coin      `json:"price,omitempty"` // optional price paid for the sub-tokens, royalty is charged from it to the owner
+	Owner       sdk.AccAddress `json:"owner,omitempty"` // optional owner of the sub-tokens when the sender is an approved operator
 }
 
 // NewMsgTransferNFT is a constructor function for MsgSetName
@@ -234,6 +270,26 @@ func NewMsgTransferNFT(sender, recipient sdk.AccAddress, denom, id string, subTo
 	}
 }
 
+// WithPrice returns the message transferring sub-tokens sold for the price
+func (msg MsgTransferNFT) WithPrice(price sdk.Coin) MsgTransferNFT {
+	msg.Price = &price
+	return msg
+}
+
+// WithOwner returns the message transferring sub-tokens of the owner by the approved sender
+func (msg MsgTransferNFT) WithOwner(owner sdk.AccAddress) MsgTransferNFT {
+	msg.Owner = owner
+	return msg
+}
+
+// From returns the address the sub-tokens are transferred from
+func (msg MsgTransferNFT) From() sdk.AccAddress {
+	if !msg.Owner.Empty() {
+		return msg.Owner
+	}
+	return msg.Sender
+}
+
 // Route Implements Msg
 func (msg MsgTransferNFT) Route() string { return RouterKey }
 
@@ -251,7 +307,7 @@ func (msg MsgTransferNFT) ValidateBasic() error {
 	if msg.Recipient.Empty() {
 		return ErrInvalidRecipientAddress(msg.Recipient.String())
 	}
-	if msg.Sender.Equals(msg.Recipient) {
+	if msg.From().Equals(msg.Recipient) {
 		return ErrForbiddenToTransferToYourself()
 	}
 	if strings.TrimSpace(msg.ID) == "" {
@@ -260,6 +316,9 @@ func (msg MsgTransferNFT) ValidateBasic() error {
 	if !CheckUnique(msg.SubTokenIDs) {
 		return ErrNotUniqueSubTokenIDs()
 	}
+	if msg.Price != nil && (!msg.Price.IsValid() || msg.Price.IsZero()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Price.String())
+	}
 
 	return nil
 }
@@ -329,6 +388,550 @@ func (msg MsgEditNFTMetadata) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+/* --------------------------------------------------------------------------- */
+// MsgTransferCollectionOwnership
+/* --------------------------------------------------------------------------- */
+
+type MsgTransferCollectionOwnership struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	Denom    string         `json:"denom"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+// NewMsgTransferCollectionOwnership is a constructor function for MsgTransferCollectionOwnership
+func NewMsgTransferCollectionOwnership(sender sdk.AccAddress, denom string, newOwner sdk.AccAddress) MsgTransferCollectionOwnership {
+	return MsgTransferCollectionOwnership{
+		Sender:   sender,
+		Denom:    strings.TrimSpace(denom),
+		NewOwner: newOwner,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgTransferCollectionOwnership) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgTransferCollectionOwnership) Type() string { return "transfer_collection_ownership" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferCollectionOwnership) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidDenom(msg.Denom)
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.NewOwner.Empty() {
+		return ErrInvalidRecipientAddress(msg.NewOwner.String())
+	}
+	if msg.Sender.Equals(msg.NewOwner) {
+		return ErrForbiddenToTransferToYourself()
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferCollectionOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferCollectionOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgEditCollectionMetadata
+/* --------------------------------------------------------------------------- */
+
+type MsgEditCollectionMetadata struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Denom       string         `json:"denom"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	URI         string         `json:"uri"`
+}
+
+// NewMsgEditCollectionMetadata is a constructor function for MsgEditCollectionMetadata
+func NewMsgEditCollectionMetadata(sender sdk.AccAddress, denom, name, description, uri string) MsgEditCollectionMetadata {
+	return MsgEditCollectionMetadata{
+		Sender:      sender,
+		Denom:       strings.TrimSpace(denom),
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		URI:         strings.TrimSpace(uri),
+	}
+}
+
+const (
+	MaxCollectionNameLength        = 255
+	MaxCollectionDescriptionLength = 1024
+	MaxCollectionURILength         = 255
+)
+
+// Route Implements Msg
+func (msg MsgEditCollectionMetadata) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgEditCollectionMetadata) Type() string { return "edit_collection_metadata" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEditCollectionMetadata) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidDenom(msg.Denom)
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if len(msg.Name) > MaxCollectionNameLength {
+		return ErrInvalidCollectionMetadata("name")
+	}
+	if len(msg.Description) > MaxCollectionDescriptionLength {
+		return ErrInvalidCollectionMetadata("description")
+	}
+	if len(msg.URI) > MaxCollectionURILength {
+		return ErrInvalidCollectionMetadata("uri")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEditCollectionMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEditCollectionMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgSetCollectionMinter
+/* --------------------------------------------------------------------------- */
+
+type MsgSetCollectionMinter struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Denom   string         `json:"denom"`
+	Minter  sdk.AccAddress `json:"minter"`
+	Allowed bool           `json:"allowed"`
+}
+
+// NewMsgSetCollectionMinter is a constructor function for MsgSetCollectionMinter
+func NewMsgSetCollectionMinter(sender sdk.AccAddress, denom string, minter sdk.AccAddress, allowed bool) MsgSetCollectionMinter {
+	return MsgSetCollectionMinter{
+		Sender:  sender,
+		Denom:   strings.TrimSpace(denom),
+		Minter:  minter,
+		Allowed: allowed,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetCollectionMinter) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetCollectionMinter) Type() string { return "set_collection_minter" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetCollectionMinter) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidDenom(msg.Denom)
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.Minter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetCollectionMinter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetCollectionMinter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgListNFT
+/* --------------------------------------------------------------------------- */
+
+type MsgListNFT struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Denom       string         `json:"denom"`
+	ID          string         `json:"id"`
+	SubTokenIDs []int64        `json:"sub_token_ids"`
+	Price       sdk.Coin       `json:"price"`
+}
+
+// NewMsgListNFT is a constructor function for MsgListNFT
+func NewMsgListNFT(sender sdk.AccAddress, denom, id string, subTokenIDs []int64, price sdk.Coin) MsgListNFT {
+	return MsgListNFT{
+		Sender:      sender,
+		Denom:       strings.TrimSpace(denom),
+		ID:          strings.TrimSpace(id),
+		SubTokenIDs: subTokenIDs,
+		Price:       price,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgListNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgListNFT) Type() string { return "list_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgListNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return validateListedSubTokens(msg.Denom, msg.ID, msg.SubTokenIDs, msg.Price)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgListNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgListNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgCreateAuction
+/* --------------------------------------------------------------------------- */
+
+type MsgCreateAuction struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	Denom        string         `json:"denom"`
+	ID           string         `json:"id"`
+	SubTokenIDs  []int64        `json:"sub_token_ids"`
+	ReservePrice sdk.Coin       `json:"reserve_price"`
+	EndHeight    int64          `json:"end_height"`
+}
+
+// NewMsgCreateAuction is a constructor function for MsgCreateAuction
+func NewMsgCreateAuction(sender sdk.AccAddress, denom, id string, subTokenIDs []int64, reservePrice sdk.Coin, endHeight int64) MsgCreateAuction {
+	return MsgCreateAuction{
+		Sender:       sender,
+		Denom:        strings.TrimSpace(denom),
+		ID:           strings.TrimSpace(id),
+		SubTokenIDs:  subTokenIDs,
+		ReservePrice: reservePrice,
+		EndHeight:    endHeight,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreateAuction) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreateAuction) Type() string { return "create_nft_auction" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateAuction) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.EndHeight <= 0 {
+		return ErrInvalidEndHeight(msg.EndHeight)
+	}
+	return validateListedSubTokens(msg.Denom, msg.ID, msg.SubTokenIDs, msg.ReservePrice)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgCancelListing
+/* --------------------------------------------------------------------------- */
+
+type MsgCancelListing struct {
+	Sender    sdk.AccAddress `json:"sender"`
+	ListingID uint64         `json:"listing_id"`
+}
+
+// NewMsgCancelListing is a constructor function for MsgCancelListing
+func NewMsgCancelListing(sender sdk.AccAddress, listingID uint64) MsgCancelListing {
+	return MsgCancelListing{
+		Sender:    sender,
+		ListingID: listingID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelListing) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelListing) Type() string { return "cancel_nft_listing" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelListing) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelListing) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelListing) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgBuyNFT
+/* --------------------------------------------------------------------------- */
+
+type MsgBuyNFT struct {
+	Sender    sdk.AccAddress `json:"sender"`
+	ListingID uint64         `json:"listing_id"`
+}
+
+// NewMsgBuyNFT is a constructor function for MsgBuyNFT
+func NewMsgBuyNFT(sender sdk.AccAddress, listingID uint64) MsgBuyNFT {
+	return MsgBuyNFT{
+		Sender:    sender,
+		ListingID: listingID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBuyNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBuyNFT) Type() string { return "buy_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBuyNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBuyNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBuyNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgBidNFT
+/* --------------------------------------------------------------------------- */
+
+type MsgBidNFT struct {
+	Sender    sdk.AccAddress `json:"sender"`
+	ListingID uint64         `json:"listing_id"`
+	Amount    sdk.Coin       `json:"amount"`
+}
+
+// NewMsgBidNFT is a constructor function for MsgBidNFT
+func NewMsgBidNFT(sender sdk.AccAddress, listingID uint64, amount sdk.Coin) MsgBidNFT {
+	return MsgBidNFT{
+		Sender:    sender,
+		ListingID: listingID,
+		Amount:    amount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBidNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBidNFT) Type() string { return "bid_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBidNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return ErrInvalidPrice(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBidNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBidNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgApproveNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgApproveNFT approves the operator to transfer the sub-tokens of the sender.
+// When the NFT ID is empty the operator is approved for all the sub-tokens of the collection.
+type MsgApproveNFT struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Operator    sdk.AccAddress `json:"operator"`
+	Denom       string         `json:"denom"`
+	ID          string         `json:"id,omitempty"`
+	SubTokenIDs []int64        `json:"sub_token_ids,omitempty"`
+}
+
+// NewMsgApproveNFT is a constructor function for MsgApproveNFT
+func NewMsgApproveNFT(sender, operator sdk.AccAddress, denom, id string, subTokenIDs []int64) MsgApproveNFT {
+	return MsgApproveNFT{
+		Sender:      sender,
+		Operator:    operator,
+		Denom:       strings.TrimSpace(denom),
+		ID:          strings.TrimSpace(id),
+		SubTokenIDs: subTokenIDs,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgApproveNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgApproveNFT) Type() string { return "approve_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgApproveNFT) ValidateBasic() error {
+	return validateApproval(msg.Sender, msg.Operator, msg.Denom, msg.ID, msg.SubTokenIDs)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgApproveNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgApproveNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRevokeNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgRevokeNFT revokes the approval given by MsgApproveNFT with the same fields
+type MsgRevokeNFT struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Operator    sdk.AccAddress `json:"operator"`
+	Denom       string         `json:"denom"`
+	ID          string         `json:"id,omitempty"`
+	SubTokenIDs []int64        `json:"sub_token_ids,omitempty"`
+}
+
+// NewMsgRevokeNFT is a constructor function for MsgRevokeNFT
+func NewMsgRevokeNFT(sender, operator sdk.AccAddress, denom, id string, subTokenIDs []int64) MsgRevokeNFT {
+	return MsgRevokeNFT{
+		Sender:      sender,
+		Operator:    operator,
+		Denom:       strings.TrimSpace(denom),
+		ID:          strings.TrimSpace(id),
+		SubTokenIDs: subTokenIDs,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRevokeNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevokeNFT) Type() string { return "revoke_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeNFT) ValidateBasic() error {
+	return validateApproval(msg.Sender, msg.Operator, msg.Denom, msg.ID, msg.SubTokenIDs)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateApproval(sender, operator sdk.AccAddress, denom, id string, subTokenIDs []int64) error {
+	if sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if operator.Empty() || operator.Equals(sender) {
+		return ErrInvalidOperator(operator.String())
+	}
+	if strings.TrimSpace(denom) == "" {
+		return ErrInvalidDenom(denom)
+	}
+	if strings.TrimSpace(id) == "" {
+		// operator approval for the whole collection
+		if len(subTokenIDs) != 0 {
+			return ErrInvalidTokenID(id)
+		}
+		return nil
+	}
+	if len(subTokenIDs) == 0 {
+		return ErrInvalidQuantity("0")
+	}
+	if !CheckUnique(subTokenIDs) {
+		return ErrNotUniqueSubTokenIDs()
+	}
+	return nil
+}
+
+func validateListedSubTokens(denom, id string, subTokenIDs []int64, price sdk.Coin) error {
+	if strings.TrimSpace(denom) == "" {
+		return ErrInvalidDenom(denom)
+	}
+	if strings.TrimSpace(id) == "" {
+		return ErrInvalidTokenID(id)
+	}
+	if len(subTokenIDs) == 0 {
+		return ErrInvalidQuantity("0")
+	}
+	if !CheckUnique(subTokenIDs) {
+		return ErrNotUniqueSubTokenIDs()
+	}
+	if !price.IsValid() || price.IsZero() {
+		return ErrInvalidPrice(price.String())
+	}
+	return nil
+}
+
 /* --------------------------------------------------------------------------- */
 func CheckUnique(arr []int64) bool {
 	for i, el := range arr {
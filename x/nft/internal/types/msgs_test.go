@@ -152,6 +152,24 @@ func TestMsgMsgMintNFTValidateBasicMethod(t *testing.T) {
 	newMsgMintNFT = NewMsgMintNFT(Addrs[0], Addrs[1], ID1, Denom1, TokenURI1, sdk.NewInt(1), helpers.BipToPip(sdk.NewInt(100)), true)
 	err = newMsgMintNFT.ValidateBasic()
 	require.NoError(t, err)
+
+	err = newMsgMintNFT.WithRoyalty(Addrs[2], 0).ValidateBasic()
+	require.Error(t, err)
+
+	err = newMsgMintNFT.WithRoyalty(nil, 100).ValidateBasic()
+	require.Error(t, err)
+
+	err = newMsgMintNFT.WithRoyalty(Addrs[2], MaxRoyaltyBasisPoints+1).ValidateBasic()
+	require.Error(t, err)
+
+	err = newMsgMintNFT.WithRoyalty(Addrs[2], MaxRoyaltyBasisPoints).ValidateBasic()
+	require.NoError(t, err)
+
+	err = newMsgMintNFT.WithTransferRestrictions(true, -1).ValidateBasic()
+	require.Error(t, err)
+
+	err = newMsgMintNFT.WithTransferRestrictions(false, 100).ValidateBasic()
+	require.NoError(t, err)
 }
 
 func TestMsgMintNFTGetSignBytesMethod(t *testing.T) {
@@ -213,3 +231,40 @@ func TestMsgBurnNFTGetSignersMethod(t *testing.T) {
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, Addrs[0].String(), signers[0].String())
 }
+
+func TestMarketMsgsValidateBasicMethod(t *testing.T) {
+	price := sdk.NewCoin("del", sdk.NewInt(100))
+
+	require.NoError(t, NewMsgListNFT(Addrs[0], Denom1, ID1, []int64{1, 2}, price).ValidateBasic())
+	require.Error(t, NewMsgListNFT(nil, Denom1, ID1, []int64{1}, price).ValidateBasic())
+	require.Error(t, NewMsgListNFT(Addrs[0], "", ID1, []int64{1}, price).ValidateBasic())
+	require.Error(t, NewMsgListNFT(Addrs[0], Denom1, ID1, []int64{}, price).ValidateBasic())
+	require.Error(t, NewMsgListNFT(Addrs[0], Denom1, ID1, []int64{1, 1}, price).ValidateBasic())
+	require.Error(t, NewMsgListNFT(Addrs[0], Denom1, ID1, []int64{1}, sdk.NewCoin("del", sdk.ZeroInt())).ValidateBasic())
+
+	require.NoError(t, NewMsgCreateAuction(Addrs[0], Denom1, ID1, []int64{1}, price, 100).ValidateBasic())
+	require.Error(t, NewMsgCreateAuction(Addrs[0], Denom1, ID1, []int64{1}, price, 0).ValidateBasic())
+
+	require.NoError(t, NewMsgBidNFT(Addrs[0], 1, price).ValidateBasic())
+	require.Error(t, NewMsgBidNFT(Addrs[0], 1, sdk.NewCoin("del", sdk.ZeroInt())).ValidateBasic())
+
+	require.NoError(t, NewMsgBuyNFT(Addrs[0], 1).ValidateBasic())
+	require.Error(t, NewMsgCancelListing(nil, 1).ValidateBasic())
+}
+
+func TestApprovalMsgsValidateBasicMethod(t *testing.T) {
+	require.NoError(t, NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1, 2}).ValidateBasic())
+	require.NoError(t, NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, "", nil).ValidateBasic())
+	require.Error(t, NewMsgApproveNFT(Addrs[0], Addrs[0], Denom1, "", nil).ValidateBasic())
+	require.Error(t, NewMsgApproveNFT(Addrs[0], nil, Denom1, "", nil).ValidateBasic())
+	require.Error(t, NewMsgApproveNFT(Addrs[0], Addrs[1], "", "", nil).ValidateBasic())
+	require.Error(t, NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, "", []int64{1}).ValidateBasic())
+	require.Error(t, NewMsgApproveNFT(Addrs[0], Addrs[1], Denom1, ID1, nil).ValidateBasic())
+	require.Error(t, NewMsgRevokeNFT(Addrs[0], Addrs[1], Denom1, ID1, []int64{1, 1}).ValidateBasic())
+
+	// an operator can transfer sub-tokens to itself but not back to their owner
+	transfer := NewMsgTransferNFT(Addrs[1], Addrs[1], Denom1, ID1, []int64{1}).WithOwner(Addrs[0])
+	require.NoError(t, transfer.ValidateBasic())
+	transfer = NewMsgTransferNFT(Addrs[1], Addrs[0], Denom1, ID1, []int64{1}).WithOwner(Addrs[0])
+	require.Error(t, transfer.ValidateBasic())
+}
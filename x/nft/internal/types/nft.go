@@ -22,6 +22,12 @@ type BaseNFT struct {
 	TokenURI  string               `json:"token_uri" yaml:"token_uri"` // optional extra properties available for querying
 	Reserve   sdk.Int              `json:"reserve" yaml:"reserve"`
 	AllowMint bool                 `json:"allow_mint" yaml:"allow_mint"`
+
+	RoyaltyRecipient   sdk.AccAddress `json:"royalty_recipient,omitempty" yaml:"royalty_recipient"`
+	RoyaltyBasisPoints uint64         `json:"royalty_basis_points,omitempty" yaml:"royalty_basis_points"`
+
+	NonTransferable     bool  `json:"non_transferable,omitempty" yaml:"non_transferable"`
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty" yaml:"transfer_locked_until"`
 }
 
 // NewBaseNFT creates a new NFT instance
@@ -69,6 +75,45 @@ func (bnft BaseNFT) GetAllowMint() bool {
 	return bnft.AllowMint
 }
 
+// GetRoyaltyRecipient returns the address receiving royalties on sales of the NFT
+func (bnft BaseNFT) GetRoyaltyRecipient() sdk.AccAddress {
+	return bnft.RoyaltyRecipient
+}
+
+// GetRoyaltyBasisPoints returns the royalty paid on sales of the NFT in basis points of the price
+func (bnft BaseNFT) GetRoyaltyBasisPoints() uint64 {
+	return bnft.RoyaltyBasisPoints
+}
+
+// SetRoyalty sets the royalty of the NFT
+func (bnft BaseNFT) SetRoyalty(recipient sdk.AccAddress, basisPoints uint64) exported.NFT {
+	bnft.RoyaltyRecipient = recipient
+	bnft.RoyaltyBasisPoints = basisPoints
+	return bnft
+}
+
+// IsNonTransferable returns whether sub-tokens of the NFT can never be transferred by holders
+func (bnft BaseNFT) IsNonTransferable() bool {
+	return bnft.NonTransferable
+}
+
+// GetTransferLockedUntil returns the height before which sub-tokens of the NFT can not be transferred by holders
+func (bnft BaseNFT) GetTransferLockedUntil() int64 {
+	return bnft.TransferLockedUntil
+}
+
+// IsTransferable returns whether holders can transfer sub-tokens of the NFT at the height
+func (bnft BaseNFT) IsTransferable(height int64) bool {
+	return !bnft.NonTransferable && height >= bnft.TransferLockedUntil
+}
+
+// SetTransferRestrictions sets the transfer restrictions of the NFT
+func (bnft BaseNFT) SetTransferRestrictions(nonTransferable bool, lockedUntil int64) exported.NFT {
+	bnft.NonTransferable = nonTransferable
+	bnft.TransferLockedUntil = lockedUntil
+	return bnft
+}
+
 func (bnft BaseNFT) String() string {
 	return fmt.Sprintf(`ID:				%s
 Owners:			%s
@@ -90,6 +135,12 @@ type BaseNFTJSON struct {
 	TokenURI  string         `json:"token_uri" yaml:"token_uri"` // optional extra properties available for querying
 	Reserve   sdk.Int        `json:"reserve" yaml:"reserve"`
 	AllowMint bool           `json:"allow_mint" yaml:"allow_mint"`
+
+	RoyaltyRecipient   sdk.AccAddress `json:"royalty_recipient,omitempty" yaml:"royalty_recipient"`
+	RoyaltyBasisPoints uint64         `json:"royalty_basis_points,omitempty" yaml:"royalty_basis_points"`
+
+	NonTransferable     bool  `json:"non_transferable,omitempty" yaml:"non_transferable"`
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty" yaml:"transfer_locked_until"`
 }
 
 func (bnft BaseNFT) MarshalJSON() ([]byte, error) {
@@ -100,6 +151,12 @@ func (bnft BaseNFT) MarshalJSON() ([]byte, error) {
 		TokenURI:  bnft.TokenURI,
 		Reserve:   bnft.Reserve,
 		AllowMint: bnft.AllowMint,
+
+		RoyaltyRecipient:   bnft.RoyaltyRecipient,
+		RoyaltyBasisPoints: bnft.RoyaltyBasisPoints,
+
+		NonTransferable:     bnft.NonTransferable,
+		TransferLockedUntil: bnft.TransferLockedUntil,
 	}
 	return json.Marshal(b)
 }
@@ -117,6 +174,10 @@ func (bnft *BaseNFT) UnmarshalJSON(b []byte) error {
 	bnft.Reserve = nft.Reserve
 	bnft.Owners = &nft.Owners
 	bnft.AllowMint = nft.AllowMint
+	bnft.RoyaltyRecipient = nft.RoyaltyRecipient
+	bnft.RoyaltyBasisPoints = nft.RoyaltyBasisPoints
+	bnft.NonTransferable = nft.NonTransferable
+	bnft.TransferLockedUntil = nft.TransferLockedUntil
 	return nil
 }
 
@@ -148,6 +209,24 @@ func TransferNFT(nft exported.NFT, sender, recipient sdk.AccAddress, subTokenIDs
 	return nft, nil
 }
 
+// CalculateRoyalty returns the royalty of the NFT to be paid from the price
+func CalculateRoyalty(nft exported.NFT, price sdk.Coin) sdk.Coin {
+	if nft.GetRoyaltyRecipient().Empty() || nft.GetRoyaltyBasisPoints() == 0 {
+		return sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	}
+	amount := price.Amount.Mul(sdk.NewIntFromUint64(nft.GetRoyaltyBasisPoints())).QuoRaw(BasisPointsDenominator)
+	return sdk.NewCoin(price.Denom, amount)
+}
+
+// CheckTransferable returns an error when the holder can not move sub-tokens of the NFT at the height.
+// The creator is always allowed to move sub-tokens it holds, so it can distribute non-transferable NFTs.
+func CheckTransferable(nft exported.NFT, denom string, holder sdk.AccAddress, height int64) error {
+	if nft.IsTransferable(height) || nft.GetCreator().Equals(holder) {
+		return nil
+	}
+	return ErrNFTNotTransferable(denom, nft.GetID())
+}
+
 // ----------------------------------------------------------------------------
 // NFT
 
@@ -256,7 +335,12 @@ func (nfts NFTs) MarshalJSON() ([]byte, error) {
 	nftJSON := make(NFTJSON)
 	for _, nft := range nfts {
 		id := nft.GetID()
-		nftJSON[id] = *nft.(*BaseNFT)
+		switch nft := nft.(type) {
+		case *BaseNFT:
+			nftJSON[id] = *nft
+		case BaseNFT:
+			nftJSON[id] = nft
+		}
 	}
 	return json.Marshal(nftJSON)
 }
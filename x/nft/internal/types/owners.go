@@ -250,6 +250,18 @@ func (t TokenOwners) SetOwner(owner exported.TokenOwner) exported.TokenOwners {
 	return t
 }
 
+func (t TokenOwners) RemoveOwner(address sdk.AccAddress) exported.TokenOwners {
+	for i, o := range t.Owners {
+		if o.GetAddress().Equals(address) {
+			owners := make([]exported.TokenOwner, 0, len(t.Owners)-1)
+			owners = append(owners, t.Owners[:i]...)
+			t.Owners = append(owners, t.Owners[i+1:]...)
+			return t
+		}
+	}
+	return t
+}
+
 func (t TokenOwners) GetOwner(address sdk.AccAddress) exported.TokenOwner {
 	for _, owner := range t.Owners {
 		if owner.GetAddress().Equals(address) {
@@ -1,3 +1,6 @@
 package types
 
 const ReservedPool = "reserved_pool"
+
+// MarketPool holds listed sub-tokens and bids of active auctions
+const MarketPool = "nft_market_pool"
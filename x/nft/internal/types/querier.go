@@ -4,6 +4,14 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/exported"
+)
+
+// Page sizes of paginated queries
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
 )
 
 // QueryCollectionParams defines the params for queries:
@@ -70,6 +78,92 @@ func NewQuerySubTokensParams(denom, id string, subTokenIDs []int64) QuerySubToke
 	}
 }
 
+// QueryListingParams params for query 'custom/nft/listing'
+type QueryListingParams struct {
+	ID uint64 `json:"id"`
+}
+
+// NewQueryListingParams creates a new instance of QueryListingParams
+func NewQueryListingParams(id uint64) QueryListingParams {
+	return QueryListingParams{ID: id}
+}
+
+// QueryListingsParams params for query 'custom/nft/listings'.
+// Empty fields do not filter listings.
+type QueryListingsParams struct {
+	Denom  string         `json:"denom"`
+	Seller sdk.AccAddress `json:"seller"`
+	Status string         `json:"status"`
+}
+
+// NewQueryListingsParams creates a new instance of QueryListingsParams
+func NewQueryListingsParams(denom string, seller sdk.AccAddress, status string) QueryListingsParams {
+	return QueryListingsParams{
+		Denom:  denom,
+		Seller: seller,
+		Status: status,
+	}
+}
+
+// QueryPageParams params for paginated queries:
+// - 'custom/nft/collection_nfts' of NFTs in the denom
+// - 'custom/nft/held_sub_tokens' of sub-tokens held by the address
+// - 'custom/nft/created_nfts' of NFTs created by the address
+// Cursor is the hex encoded next cursor returned with the previous page, the first page is returned for an empty cursor.
+type QueryPageParams struct {
+	Denom   string         `json:"denom"`
+	Address sdk.AccAddress `json:"address"`
+	Cursor  string         `json:"cursor"`
+	Limit   int            `json:"limit"`
+}
+
+// NewQueryPageParams creates a new instance of QueryPageParams
+func NewQueryPageParams(denom string, address sdk.AccAddress, cursor string, limit int) QueryPageParams {
+	return QueryPageParams{
+		Denom:   denom,
+		Address: address,
+		Cursor:  cursor,
+		Limit:   limit,
+	}
+}
+
+// PageLimit returns the page size limited by MaxPageLimit
+func (q QueryPageParams) PageLimit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultPageLimit
+	case q.Limit > MaxPageLimit:
+		return MaxPageLimit
+	default:
+		return q.Limit
+	}
+}
+
+// ResponseNFT is an NFT of a page together with its denom
+type ResponseNFT struct {
+	Denom string       `json:"denom"`
+	NFT   exported.NFT `json:"nft"`
+}
+
+// ResponseNFTsPage is a page of NFTs. NextCursor is empty for the last page.
+type ResponseNFTsPage struct {
+	NFTs       []ResponseNFT `json:"nfts"`
+	NextCursor string        `json:"next_cursor"`
+}
+
+// HeldSubTokens are sub-tokens of an NFT held by an address
+type HeldSubTokens struct {
+	Denom       string  `json:"denom"`
+	ID          string  `json:"id"`
+	SubTokenIDs []int64 `json:"sub_token_ids"`
+}
+
+// ResponseHeldSubTokensPage is a page of sub-tokens held by an address. NextCursor is empty for the last page.
+type ResponseHeldSubTokensPage struct {
+	SubTokens  []HeldSubTokens `json:"sub_tokens"`
+	NextCursor string          `json:"next_cursor"`
+}
+
 type ResponseSubTokens []ResponseSubToken
 
 type ResponseSubToken struct {
@@ -7,24 +7,44 @@ import (
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/x/auth/exported"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/exported"
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding auth type
+// DecodeStore unmarshals the KVPair's Value to the corresponding nft type
 func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 	switch {
-	case bytes.Equal(kvA.Key[:1], types.AddressStoreKeyPrefix):
-		var accA, accB exported.Account
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &accA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &accB)
-		return fmt.Sprintf("%v\n%v", accA, accB)
-	case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
-		var globalAccNumberA, globalAccNumberB uint64
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &globalAccNumberA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
-		return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
+	case bytes.HasPrefix(kvA.Key, types.CollectionsKeyPrefix):
+		var collectionA, collectionB types.Collection
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &collectionA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &collectionB)
+		return fmt.Sprintf("%v\n%v", collectionA, collectionB)
+	case bytes.HasPrefix(kvA.Key, types.NFTKeyPrefix):
+		var nftA, nftB exported.NFT
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &nftA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &nftB)
+		return fmt.Sprintf("%s\n%s", nftString(nftA), nftString(nftB))
+	case bytes.HasPrefix(kvA.Key, types.NFTOwnerKeyPrefix):
+		var ownerA, ownerB exported.TokenOwner
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &ownerA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &ownerB)
+		return fmt.Sprintf("%v\n%v", ownerA, ownerB)
+	case bytes.HasPrefix(kvA.Key, types.SubTokenKeyPrefix):
+		var reserveA, reserveB sdk.Int
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &reserveA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &reserveB)
+		return fmt.Sprintf("ReserveA: %s\nReserveB: %s", reserveA, reserveB)
 	default:
-		panic(fmt.Sprintf("invalid account key %X", kvA.Key))
+		panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 	}
 }
+
+// nftString returns the NFT with its transfer restrictions which are not a part of the NFT string
+func nftString(nft exported.NFT) string {
+	return fmt.Sprintf(`%v
+NonTransferable:	%t
+TransferLockedUntil:	%d`,
+		nft, nft.IsNonTransferable(), nft.GetTransferLockedUntil())
+}
@@ -11,7 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"bitbucket.org/decimalteam/go-node/x/nft/internal/types"
 )
 
 var (
@@ -29,20 +30,27 @@ func makeTestCodec() (cdc *codec.Codec) {
 
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
-	acc := types.NewBaseAccountWithAddress(delAddr1)
-	globalAccNumber := uint64(10)
+	nft := types.NewBaseNFT("token1", delAddr1, delAddr1, "uri", sdk.NewInt(100), []int64{1}, true).
+		SetTransferRestrictions(true, 1000)
+	collection := types.NewCollection("denom1", types.NewNFTs(nft))
+	owner := types.NewTokenOwner(delAddr1, []int64{1, 2})
+	reserve := sdk.NewInt(100)
 
 	kvPairs := tmkv.Pairs{
-		tmkv.Pair{Key: types.AddressStoreKey(delAddr1), Value: cdc.MustMarshalBinaryBare(acc)},
-		tmkv.Pair{Key: types.GlobalAccountNumberKey, Value: cdc.MustMarshalBinaryLengthPrefixed(globalAccNumber)},
+		tmkv.Pair{Key: types.GetCollectionKey("denom1"), Value: cdc.MustMarshalBinaryLengthPrefixed(collection)},
+		tmkv.Pair{Key: types.GetNFTKey("denom1", "token1"), Value: cdc.MustMarshalBinaryLengthPrefixed(nft)},
+		tmkv.Pair{Key: types.GetNFTOwnerKey("denom1", "token1", delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(owner)},
+		tmkv.Pair{Key: types.GetSubTokenKey("denom1", "token1", 1), Value: cdc.MustMarshalBinaryLengthPrefixed(reserve)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 	tests := []struct {
 		name        string
 		expectedLog string
 	}{
-		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
-		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"Collection", fmt.Sprintf("%v\n%v", collection, collection)},
+		{"NFT", fmt.Sprintf("%s\n%s", nftString(nft), nftString(nft))},
+		{"NFTOwner", fmt.Sprintf("%v\n%v", owner, owner)},
+		{"SubToken", fmt.Sprintf("ReserveA: %s\nReserveB: %s", reserve, reserve)},
 		{"other", ""},
 	}
 
@@ -57,4 +65,8 @@ func TestDecodeStore(t *testing.T) {
 			}
 		})
 	}
+
+	decoded := DecodeStore(cdc, kvPairs[1], kvPairs[1])
+	require.Contains(t, decoded, "NonTransferable:	true")
+	require.Contains(t, decoded, "TransferLockedUntil:	1000")
 }
@@ -29,6 +29,8 @@ type (
 	MsgChainDeactivate = types.MsgChainDeactivate
 	MsgChainActivate   = types.MsgChainActivate
 	GenesisState       = types.GenesisState
+	Params             = types.Params
+	ChainFee           = types.ChainFee
 )
 
 var (
@@ -40,7 +42,9 @@ var (
 	ServiceAccAddress = types.ServiceAccAddress
 	ServiceAddress    = types.ServiceAddress
 
-	NewKeeper = keeper.NewKeeper
+	NewKeeper   = keeper.NewKeeper
+	NewParams   = types.NewParams
+	NewChainFee = types.NewChainFee
 
 	NewMsgRedeem          = types.NewMsgRedeem
 	NewMsgHTLT            = types.NewMsgHTLT
@@ -4,12 +4,25 @@ import flag "github.com/spf13/pflag"
 
 const (
 	FlagHash = "hash"
+
+	FlagKeyFile       = "key-file"
+	FlagTarget        = "target"
+	FlagTargetChainID = "target-chain-id"
+	FlagChain         = "chain"
+
+	TargetMock = "mock"
 )
 
 var (
-	FsHash = flag.NewFlagSet("", flag.ContinueOnError)
+	FsHash    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRelayer = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsHash.String(FlagHash, "", "HashedSecret of secret. If not specified, it will be random")
+
+	FsRelayer.String(FlagKeyFile, "", "File with hex encoded secp256k1 private key used to sign swaps")
+	FsRelayer.String(FlagTarget, TargetMock, "Node URI where redeems are submitted or 'mock' to use in-memory counter chain")
+	FsRelayer.String(FlagTargetChainID, "", "Chain ID of the target node")
+	FsRelayer.Int(FlagChain, 0, "Number of the target chain, swaps to other chains are skipped")
 }
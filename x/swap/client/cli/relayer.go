@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	tmservice "github.com/tendermint/tendermint/libs/service"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/client/relayer"
+)
+
+// GetRelayerCmd returns root command of the swap service tools
+func GetRelayerCmd(cdc *codec.Codec) *cobra.Command {
+	swapCmd := &cobra.Command{
+		Use:   "swap",
+		Short: "Swap service subcommands",
+	}
+
+	swapCmd.AddCommand(GetCmdRelayer(cdc))
+
+	return swapCmd
+}
+
+func GetCmdRelayer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer --key-file [file] --chain [number] [--target [node | mock]] [--target-chain-id] [--from]",
+		Short: "Watch swap initializations and redeem them on the target chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+			key, err := ioutil.ReadFile(viper.GetString(FlagKeyFile))
+			if err != nil {
+				return err
+			}
+			signer, err := relayer.NewSignerFromHex(string(key))
+			if err != nil {
+				return err
+			}
+
+			var target relayer.Target
+			targetURI := viper.GetString(FlagTarget)
+			if targetURI == TargetMock {
+				target = relayer.NewMockChain(signer.CheckingAddress())
+			} else {
+				targetCtx := clientcontext.NewCLIContext().
+					WithCodec(cdc).
+					WithNodeURI(targetURI).
+					WithChainID(viper.GetString(FlagTargetChainID)).
+					WithBroadcastMode(flags.BroadcastBlock)
+				txBldr := auth.NewTxBuilderFromCLI(targetCtx.Input).
+					WithTxEncoder(utils.GetTxEncoder(cdc)).
+					WithChainID(viper.GetString(FlagTargetChainID))
+				target = relayer.NewNodeTarget(targetCtx, txBldr)
+			}
+
+			cliCtx := clientcontext.NewCLIContext().WithCodec(cdc)
+			client, ok := cliCtx.Client.(tmservice.Service)
+			if !ok {
+				return fmt.Errorf("source node is not set")
+			}
+			err = client.Start()
+			if err != nil {
+				return err
+			}
+			defer client.Stop() // nolint: errcheck
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				sig := make(chan os.Signal, 1)
+				signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+				<-sig
+				cancel()
+			}()
+
+			logger.Info("swap relayer started", "signer", signer.CheckingAddress(), "target", targetURI)
+			r := relayer.NewRelayer(signer, target, cliCtx.GetFromAddress(), viper.GetInt(FlagChain), logger)
+			return r.Run(ctx, cliCtx.Client)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsRelayer)
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface of the source node")
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of key used to submit redeems to the target node")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flags.FlagFees, "", "Fees to pay along with redeem transactions")
+	cmd.Flags().String(flags.FlagGasPrices, "", "Gas prices to determine the transaction fee")
+	_ = cmd.MarkFlagRequired(FlagKeyFile)
+	_ = cmd.MarkFlagRequired(FlagChain)
+
+	return cmd
+}
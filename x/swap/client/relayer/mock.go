@@ -0,0 +1,85 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+var _ Target = &MockChain{}
+
+// MockChain is an in-memory counter chain which accepts redeems signed by the checking address
+// the same way as handleMsgRedeemV2 does. It is used to run swap round trips on one machine.
+type MockChain struct {
+	mtx             sync.Mutex
+	checkingAddress string
+	redeemed        map[types.Hash]bool
+	balances        map[string]sdk.Coins
+}
+
+func NewMockChain(checkingAddress string) *MockChain {
+	return &MockChain{
+		checkingAddress: strings.ToLower(strings.TrimPrefix(checkingAddress, "0x")),
+		redeemed:        make(map[types.Hash]bool),
+		balances:        make(map[string]sdk.Coins),
+	}
+}
+
+func (m *MockChain) Redeem(msg types.MsgRedeemV2) error {
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	transactionNumber, ok := sdk.NewIntFromString(msg.TransactionNumber)
+	if !ok {
+		return types.ErrInvalidTransactionNumber()
+	}
+
+	hash, err := types.GetHash(transactionNumber, msg.TokenSymbol, msg.Amount, msg.Recipient, msg.FromChain, msg.DestChain)
+	if err != nil {
+		return err
+	}
+
+	R := big.NewInt(0).SetBytes(msg.R[:])
+	S := big.NewInt(0).SetBytes(msg.S[:])
+	address, err := types.Ecrecover(hash, R, S, big.NewInt(int64(msg.V)))
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(address.Bytes()) != m.checkingAddress {
+		return types.ErrInvalidServiceAddress(m.checkingAddress, hex.EncodeToString(address.Bytes()))
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.redeemed[hash] {
+		return types.ErrAlreadyRedeemed()
+	}
+	m.redeemed[hash] = true
+
+	coins := sdk.NewCoins(sdk.NewCoin(strings.ToLower(msg.TokenSymbol), msg.Amount))
+	m.balances[msg.Recipient.String()] = m.balances[msg.Recipient.String()].Add(coins...)
+
+	return nil
+}
+
+// Balance returns coins redeemed to the recipient
+func (m *MockChain) Balance(recipient sdk.AccAddress) sdk.Coins {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.balances[recipient.String()]
+}
+
+// Redeemed returns number of redeemed swaps
+func (m *MockChain) Redeemed() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return len(m.redeemed)
+}
@@ -0,0 +1,52 @@
+package relayer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+var _ Target = NodeTarget{}
+
+// NodeTarget submits redeems as transactions to a Decimal node
+type NodeTarget struct {
+	cliCtx context.CLIContext
+	txBldr auth.TxBuilder
+}
+
+// NewNodeTarget creates target from the context configured with the target node, chain id and sender key
+func NewNodeTarget(cliCtx context.CLIContext, txBldr auth.TxBuilder) NodeTarget {
+	return NodeTarget{cliCtx: cliCtx, txBldr: txBldr}
+}
+
+func (t NodeTarget) Redeem(msg types.MsgRedeemV2) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	txBldr, err := utils.PrepareTxBuilder(t.txBldr, t.cliCtx)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := txBldr.BuildAndSign(t.cliCtx.GetFromName(), keys.DefaultKeyPass, []sdk.Msg{msg})
+	if err != nil {
+		return err
+	}
+
+	res, err := t.cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("redeem transaction %s failed: %s", res.TxHash, res.RawLog)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+// SubscribeQuery selects transactions containing swap initializations
+var SubscribeQuery = fmt.Sprintf("tm.event='Tx' AND %s.%s EXISTS", types.EventTypeSwapInitialize, types.AttributeKeyTransactionNumber)
+
+const subscriber = "swap-relayer"
+
+// SwapEvent is a swap initialized on the source chain
+type SwapEvent struct {
+	From              string
+	Recipient         string
+	Amount            sdk.Int
+	TokenSymbol       string
+	TransactionNumber string
+	FromChain         int
+	DestChain         int
+}
+
+// Target is a chain where signed redeems are submitted
+type Target interface {
+	Redeem(msg types.MsgRedeemV2) error
+}
+
+// ParseSwapEvents extracts swap initializations from the transaction events.
+// Amount of the returned swap is the net amount which should be redeemed on the destination chain.
+func ParseSwapEvents(events []abci.Event) ([]SwapEvent, error) {
+	var swaps []SwapEvent
+	for _, event := range events {
+		if event.Type != types.EventTypeSwapInitialize {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		amount, ok := sdk.NewIntFromString(attributes[types.AttributeKeyNetAmount])
+		if !ok {
+			return nil, fmt.Errorf("invalid swap amount: %q", attributes[types.AttributeKeyNetAmount])
+		}
+		fromChain, err := strconv.Atoi(attributes[types.AttributeKeyFromChain])
+		if err != nil {
+			return nil, fmt.Errorf("invalid from chain: %w", err)
+		}
+		destChain, err := strconv.Atoi(attributes[types.AttributeKeyDestChain])
+		if err != nil {
+			return nil, fmt.Errorf("invalid dest chain: %w", err)
+		}
+
+		swaps = append(swaps, SwapEvent{
+			From:              attributes[types.AttributeKeyFrom],
+			Recipient:         attributes[types.AttributeKeyRecipient],
+			Amount:            amount,
+			TokenSymbol:       attributes[types.AttributeKeyTokenSymbol],
+			TransactionNumber: attributes[types.AttributeKeyTransactionNumber],
+			FromChain:         fromChain,
+			DestChain:         destChain,
+		})
+	}
+	return swaps, nil
+}
+
+// Relayer signs swaps initialized on the source chain and redeems them on the target
+type Relayer struct {
+	signer Signer
+	target Target
+	sender sdk.AccAddress
+	// chain is a number of the target chain, swaps to other chains are skipped
+	chain  int
+	logger log.Logger
+}
+
+func NewRelayer(signer Signer, target Target, sender sdk.AccAddress, chain int, logger log.Logger) Relayer {
+	return Relayer{
+		signer: signer,
+		target: target,
+		sender: sender,
+		chain:  chain,
+		logger: logger,
+	}
+}
+
+// Sign builds redeem message for the swap signed by the relayer key
+func (r Relayer) Sign(swap SwapEvent) (types.MsgRedeemV2, error) {
+	recipient, err := sdk.AccAddressFromBech32(swap.Recipient)
+	if err != nil {
+		return types.MsgRedeemV2{}, fmt.Errorf("invalid recipient %q: %w", swap.Recipient, err)
+	}
+
+	transactionNumber, ok := sdk.NewIntFromString(swap.TransactionNumber)
+	if !ok {
+		return types.MsgRedeemV2{}, types.ErrInvalidTransactionNumber()
+	}
+
+	hash, err := types.GetHash(transactionNumber, swap.TokenSymbol, swap.Amount, recipient, swap.FromChain, swap.DestChain)
+	if err != nil {
+		return types.MsgRedeemV2{}, err
+	}
+
+	v, R, S, err := r.signer.Sign(hash)
+	if err != nil {
+		return types.MsgRedeemV2{}, err
+	}
+
+	return types.NewMsgRedeemV2(r.sender, recipient, swap.From, swap.Amount, swap.TokenSymbol,
+		swap.TransactionNumber, swap.FromChain, swap.DestChain, v, R, S), nil
+}
+
+// Relay signs the swap and submits redeem to the target
+func (r Relayer) Relay(swap SwapEvent) error {
+	if swap.DestChain != r.chain {
+		return nil
+	}
+
+	msg, err := r.Sign(swap)
+	if err != nil {
+		return err
+	}
+
+	return r.target.Redeem(msg)
+}
+
+// HandleEvents relays all swaps found in the transaction events. A swap failed to relay is logged
+// and doesn't stop relaying the other swaps of the transaction.
+func (r Relayer) HandleEvents(events []abci.Event) error {
+	swaps, err := ParseSwapEvents(events)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, swap := range swaps {
+		err = r.Relay(swap)
+		if err != nil {
+			failed++
+			r.logger.Error(fmt.Sprintf("failed to relay swap %s: %s", swap.TransactionNumber, err.Error()))
+			continue
+		}
+		r.logger.Info("swap relayed", "transaction_number", swap.TransactionNumber,
+			"recipient", swap.Recipient, "amount", swap.Amount.String(), "token", swap.TokenSymbol)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to relay %d of %d swaps", failed, len(swaps))
+	}
+	return nil
+}
+
+// Run subscribes to the swap transactions of the source node and relays them until the context is done
+func (r Relayer) Run(ctx context.Context, client rpcclient.Client) error {
+	events, err := client.Subscribe(ctx, subscriber, SubscribeQuery)
+	if err != nil {
+		return err
+	}
+	defer client.Unsubscribe(context.Background(), subscriber, SubscribeQuery) // nolint: errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return fmt.Errorf("subscription closed")
+			}
+			data, ok := event.Data.(tmtypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			err = r.HandleEvents(data.Result.Events)
+			if err != nil {
+				r.logger.Error(err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package relayer
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+var recipient = sdk.AccAddress([]byte("swap_relayer_test_01"))
+
+func newTestSigner(t *testing.T) Signer {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return NewSigner(key)
+}
+
+func newTestSwap() SwapEvent {
+	return SwapEvent{
+		From:              "0x1234",
+		Recipient:         recipient.String(),
+		Amount:            sdk.NewInt(1000),
+		TokenSymbol:       "del",
+		TransactionNumber: "42",
+		FromChain:         1,
+		DestChain:         2,
+	}
+}
+
+func TestRelayToMockChain(t *testing.T) {
+	signer := newTestSigner(t)
+	chain := NewMockChain(signer.CheckingAddress())
+	r := NewRelayer(signer, chain, nil, 2, log.NewNopLogger())
+
+	require.NoError(t, r.Relay(newTestSwap()))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(1000))), chain.Balance(recipient))
+
+	// the same swap can't be redeemed twice
+	require.Error(t, r.Relay(newTestSwap()))
+	require.Equal(t, 1, chain.Redeemed())
+
+	// swaps to other chains are skipped
+	swap := newTestSwap()
+	swap.DestChain = 3
+	require.NoError(t, r.Relay(swap))
+	require.Equal(t, 1, chain.Redeemed())
+}
+
+func newTestSwapEvent(swap SwapEvent) abci.Event {
+	return abci.Event(sdk.NewEvent(types.EventTypeSwapInitialize,
+		sdk.NewAttribute(types.AttributeKeyFrom, swap.From),
+		sdk.NewAttribute(types.AttributeKeyRecipient, swap.Recipient),
+		sdk.NewAttribute(types.AttributeKeyNetAmount, swap.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenSymbol, swap.TokenSymbol),
+		sdk.NewAttribute(types.AttributeKeyTransactionNumber, swap.TransactionNumber),
+		sdk.NewAttribute(types.AttributeKeyFromChain, strconv.Itoa(swap.FromChain)),
+		sdk.NewAttribute(types.AttributeKeyDestChain, strconv.Itoa(swap.DestChain)),
+	))
+}
+
+func TestHandleEventsRelaysAllSwaps(t *testing.T) {
+	signer := newTestSigner(t)
+	chain := NewMockChain(signer.CheckingAddress())
+	r := NewRelayer(signer, chain, nil, 2, log.NewNopLogger())
+
+	redeemed := newTestSwap()
+	require.NoError(t, r.Relay(redeemed))
+
+	// the swap failed to relay doesn't stop relaying the next swap of the transaction
+	swap := newTestSwap()
+	swap.TransactionNumber = "43"
+	require.Error(t, r.HandleEvents([]abci.Event{newTestSwapEvent(redeemed), newTestSwapEvent(swap)}))
+	require.Equal(t, 2, chain.Redeemed())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(2000))), chain.Balance(recipient))
+}
+
+func TestMockChainRejectsUnknownSigner(t *testing.T) {
+	chain := NewMockChain(newTestSigner(t).CheckingAddress())
+	r := NewRelayer(newTestSigner(t), chain, nil, 2, log.NewNopLogger())
+
+	require.Error(t, r.Relay(newTestSwap()))
+	require.Equal(t, 0, chain.Redeemed())
+}
+
+func TestMockChainRejectsChangedAmount(t *testing.T) {
+	signer := newTestSigner(t)
+	chain := NewMockChain(signer.CheckingAddress())
+	r := NewRelayer(signer, chain, nil, 2, log.NewNopLogger())
+
+	msg, err := r.Sign(newTestSwap())
+	require.NoError(t, err)
+	msg.Amount = msg.Amount.MulRaw(2)
+
+	require.Error(t, chain.Redeem(msg))
+}
@@ -0,0 +1,52 @@
+package relayer
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+// Signer signs swap hashes with the secp256k1 key of the swap service
+type Signer struct {
+	key *ecdsa.PrivateKey
+}
+
+func NewSigner(key *ecdsa.PrivateKey) Signer {
+	return Signer{key: key}
+}
+
+// NewSignerFromHex creates signer from hex encoded private key
+func NewSignerFromHex(hexKey string) (Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return Signer{}, fmt.Errorf("invalid private key: %w", err)
+	}
+	return NewSigner(key), nil
+}
+
+// Address returns ethereum address which is recovered from the signer signatures
+func (s Signer) Address() ethcmn.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// CheckingAddress returns address in the format of types.CheckingAddress
+func (s Signer) CheckingAddress() string {
+	return hex.EncodeToString(s.Address().Bytes())
+}
+
+// Sign returns signature of the hash in the form accepted by MsgRedeemV2
+func (s Signer) Sign(hash types.Hash) (v uint8, r types.Hash, sig types.Hash, err error) {
+	signature, err := crypto.Sign(hash[:], s.key)
+	if err != nil {
+		return 0, r, sig, err
+	}
+	copy(r[:], signature[:32])
+	copy(sig[:], signature[32:64])
+	return signature[64] + 27, r, sig, nil
+}
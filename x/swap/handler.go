@@ -232,11 +232,27 @@ func handleMsgSwapInitialize(ctx sdk.Context, k Keeper, msg types.MsgSwapInitial
 		return nil, sdkerrors.ErrInsufficientFunds
 	}
 
+	fee := sdk.ZeroInt()
+	chainFee, found := k.GetChainFee(ctx, msg.DestChain)
+	if found {
+		fee = chainFee.Compute(msg.Amount)
+		if fee.GTE(msg.Amount) {
+			return nil, types.ErrAmountLessThanFee(msg.Amount.String(), fee.String())
+		}
+	}
+
 	err = k.LockFunds(ctx, msg.From, funds)
 	if err != nil {
 		return nil, err
 	}
 
+	if fee.IsPositive() {
+		err = k.CollectFee(ctx, sdk.NewCoins(sdk.NewCoin(strings.ToLower(msg.TokenSymbol), fee)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -246,10 +262,26 @@ func handleMsgSwapInitialize(ctx sdk.Context, k Keeper, msg types.MsgSwapInitial
 			sdk.NewAttribute(types.AttributeKeyDestChain, strconv.Itoa(msg.DestChain)),
 			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyNetAmount, msg.Amount.Sub(fee).String()),
 			sdk.NewAttribute(types.AttributeKeyTransactionNumber, msg.TransactionNumber),
 			sdk.NewAttribute(types.AttributeKeyTokenSymbol, msg.TokenSymbol),
 		),
 	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapInitialize,
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyNetAmount, msg.Amount.Sub(fee).String()),
+			sdk.NewAttribute(types.AttributeKeyTokenSymbol, msg.TokenSymbol),
+			sdk.NewAttribute(types.AttributeKeyTransactionNumber, msg.TransactionNumber),
+			sdk.NewAttribute(types.AttributeKeyFromChain, strconv.Itoa(msg.FromChain)),
+			sdk.NewAttribute(types.AttributeKeyDestChain, strconv.Itoa(msg.DestChain)),
+		),
+	)
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -58,6 +58,20 @@ func (k Keeper) LockFunds(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coi
 	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolName, coins)
 }
 
+// CollectFee moves swap fee from the pool to the fee recipient or to the fee collector
+func (k Keeper) CollectFee(ctx sdk.Context, fee sdk.Coins) error {
+	recipient := k.FeeRecipient(ctx)
+	if recipient == "" {
+		return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.PoolName, auth.FeeCollectorName, fee)
+	}
+
+	address, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, recipient)
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolName, address, fee)
+}
+
 func (k Keeper) CheckPoolFunds(ctx sdk.Context, coins sdk.Coins) (bool, error) {
 	accountAddr := k.supplyKeeper.GetModuleAddress(types.PoolName)
 	if accountAddr.Empty() {
@@ -25,8 +25,28 @@ func (k Keeper) LockedTimeIn(ctx sdk.Context) (res time.Duration) {
 	return
 }
 
+func (k Keeper) ChainFees(ctx sdk.Context) (res []types.ChainFee) {
+	k.paramSpace.GetIfExists(ctx, types.KeyChainFees, &res)
+	return
+}
+
+func (k Keeper) FeeRecipient(ctx sdk.Context) (res string) {
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeRecipient, &res)
+	return
+}
+
+// GetChainFee returns swap fee configured for the destination chain
+func (k Keeper) GetChainFee(ctx sdk.Context, chainNumber int) (types.ChainFee, bool) {
+	for _, fee := range k.ChainFees(ctx) {
+		if fee.ChainNumber == chainNumber {
+			return fee, true
+		}
+	}
+	return types.ChainFee{}, false
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.LockedTimeOut(ctx), k.LockedTimeIn(ctx))
+	return types.NewParams(k.LockedTimeOut(ctx), k.LockedTimeIn(ctx), k.ChainFees(ctx), k.FeeRecipient(ctx))
 }
 
 // set the params
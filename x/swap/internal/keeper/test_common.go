@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"bitbucket.org/decimalteam/go-node/config"
+	"bitbucket.org/decimalteam/go-node/x/coin"
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+var (
+	Addrs = createTestAddrs(10)
+)
+
+// create a codec used only for testing
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	// Register Msgs
+	cdc.RegisterInterface((*sdk.Msg)(nil), nil)
+	types.RegisterCodec(cdc)
+
+	// Register AppAccount
+	cdc.RegisterInterface((*authexported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/swap/base_account", nil)
+	supply.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// CreateTestInput creates swap keeper with the pool and fee collector module accounts,
+// every address of Addrs has initCoins.
+func CreateTestInput(t *testing.T, isCheckTx bool, initCoins sdk.Coins) (sdk.Context, Keeper, auth.AccountKeeper, supply.Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyCoin := sdk.NewKVStoreKey(coin.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCoin, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	_config := sdk.GetConfig()
+	_config.SetBech32PrefixForAccount(config.DecimalPrefixAccAddr, config.DecimalPrefixAccPub)
+	_config.SetBech32PrefixForValidator(config.DecimalPrefixValAddr, config.DecimalPrefixValPub)
+	_config.SetBech32PrefixForConsensusNode(config.DecimalPrefixConsAddr, config.DecimalPrefixConsPub)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, isCheckTx, log.NewNopLogger())
+	cdc := MakeTestCodec()
+
+	pk := params.NewKeeper(cdc, keyParams, tKeyParams)
+
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), nil)
+
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.PoolName:        {supply.Minter, supply.Burner},
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(types.PoolName, supply.Minter, supply.Burner))
+
+	coinKeeper := coin.NewKeeper(cdc, keyCoin, pk.Subspace(coin.DefaultParamspace), accountKeeper, bankKeeper, supplyKeeper, config.GetDefaultConfig(config.ChainID))
+
+	keeper := NewKeeper(cdc, keyCoin, pk.Subspace(DefaultParamspace), coinKeeper, accountKeeper, supplyKeeper)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	for _, addr := range Addrs {
+		_, err := bankKeeper.AddCoins(ctx, addr, initCoins)
+		require.NoError(t, err)
+	}
+
+	return ctx, keeper, accountKeeper, supplyKeeper
+}
+
+// nolint: unparam
+func createTestAddrs(numAddrs int) []sdk.AccAddress {
+	var addresses []sdk.AccAddress
+	var buffer bytes.Buffer
+
+	// start at 100 so we can make up to 999 test addresses with valid test addresses
+	for i := 100; i < (numAddrs + 100); i++ {
+		numString := strconv.Itoa(i)
+		buffer.WriteString("A58856F0FD53BF058B4909A21AEC019107BA6") //base address string
+
+		buffer.WriteString(numString) //adding on final two digits to make addresses unique
+		res, _ := sdk.AccAddressFromHex(buffer.String())
+		addresses = append(addresses, res)
+		buffer.Reset()
+	}
+	return addresses
+}
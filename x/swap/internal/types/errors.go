@@ -26,6 +26,7 @@ const (
 	CodeInvalidServiceAddress    = 201
 	CodeInsufficientPoolFunds    = 202
 	CodeInvalidTransactionNumber = 203
+	CodeAmountLessThanFee        = 204
 
 	CodeDeprecated = 300
 )
@@ -134,6 +135,16 @@ func ErrInvalidTransactionNumber() *sdkerrors.Error {
 	)
 }
 
+func ErrAmountLessThanFee(amount string, fee string) *sdkerrors.Error {
+	return errors.Encode(
+		DefaultCodespace,
+		CodeAmountLessThanFee,
+		fmt.Sprintf("swap amount %s must be greater than fee %s", amount, fee),
+		errors.NewParam("amount", amount),
+		errors.NewParam("fee", fee),
+	)
+}
+
 func ErrDeprecated() *sdkerrors.Error {
 	return errors.Encode(
 		DefaultCodespace,
@@ -1,6 +1,8 @@
 package types
 
 const (
+	EventTypeSwapInitialize = "swap_initialize"
+
 	AttributeValueCategory        = "swap"
 	AttributeKeyTimeLocked        = "time_locked"
 	AttributeKeyTransferType      = "transfer_type"
@@ -11,5 +13,8 @@ const (
 	AttributeKeyTokenSymbol       = "token_symbol"
 	AttributeKeyTransactionNumber = "transaction_number"
 	AttributeKeyFrom              = "from"
+	AttributeKeyFromChain         = "from_chain"
 	AttributeKeyDestChain         = "dest_chain"
+	AttributeKeyFee               = "fee"
+	AttributeKeyNetAmount         = "net_amount"
 )
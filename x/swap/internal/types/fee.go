@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChainFee is a fee taken from swaps initialized to the chain
+type ChainFee struct {
+	ChainNumber int     `json:"chain_number"`
+	Percent     sdk.Dec `json:"percent"`
+	// MinAmount is a minimal fee in units of the swapped token
+	MinAmount sdk.Int `json:"min_amount"`
+}
+
+func NewChainFee(chainNumber int, percent sdk.Dec, minAmount sdk.Int) ChainFee {
+	return ChainFee{
+		ChainNumber: chainNumber,
+		Percent:     percent,
+		MinAmount:   minAmount,
+	}
+}
+
+func (f ChainFee) Validate() error {
+	if f.ChainNumber <= 0 {
+		return fmt.Errorf("chain number must be positive: %d", f.ChainNumber)
+	}
+	if f.Percent.IsNil() || f.Percent.IsNegative() || f.Percent.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee percent must be in range [0, 1): %s", f.Percent)
+	}
+	if f.MinAmount.IsNil() || f.MinAmount.IsNegative() {
+		return fmt.Errorf("fee min amount must not be negative: %s", f.MinAmount)
+	}
+	return nil
+}
+
+// Compute returns fee for the swapped amount: percent of the amount but not less than MinAmount
+func (f ChainFee) Compute(amount sdk.Int) sdk.Int {
+	fee := f.Percent.MulInt(amount).TruncateInt()
+	if fee.LT(f.MinAmount) {
+		return f.MinAmount
+	}
+	return fee
+}
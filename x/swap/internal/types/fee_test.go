@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainFeeCompute(t *testing.T) {
+	fee := NewChainFee(2, sdk.NewDecWithPrec(1, 2), sdk.NewInt(50))
+	require.NoError(t, fee.Validate())
+
+	// one percent of the amount
+	require.Equal(t, sdk.NewInt(100), fee.Compute(sdk.NewInt(10000)))
+	// minimum is taken when percent is too small
+	require.Equal(t, sdk.NewInt(50), fee.Compute(sdk.NewInt(1000)))
+
+	zero := NewChainFee(2, sdk.ZeroDec(), sdk.ZeroInt())
+	require.True(t, zero.Compute(sdk.NewInt(1000)).IsZero())
+}
+
+func TestChainFeeValidate(t *testing.T) {
+	require.Error(t, NewChainFee(0, sdk.ZeroDec(), sdk.ZeroInt()).Validate())
+	require.Error(t, NewChainFee(1, sdk.OneDec(), sdk.ZeroInt()).Validate())
+	require.Error(t, NewChainFee(1, sdk.NewDec(-1), sdk.ZeroInt()).Validate())
+	require.Error(t, NewChainFee(1, sdk.ZeroDec(), sdk.NewInt(-1)).Validate())
+
+	params := DefaultParams()
+	params.ChainFees = []ChainFee{
+		NewChainFee(1, sdk.ZeroDec(), sdk.ZeroInt()),
+		NewChainFee(1, sdk.ZeroDec(), sdk.ZeroInt()),
+	}
+	require.Error(t, params.Validate())
+}
@@ -21,17 +21,25 @@ var ServiceAccAddress, _ = sdk.AccAddressFromBech32("dx1jqx7chw0faswfmw78cdejzze
 var (
 	KeyLockedTimeOut = []byte("LockedTimeOut")
 	KeyLockedTimeIn  = []byte("LockedTimeIn")
+	KeyChainFees     = []byte("ChainFees")
+	KeyFeeRecipient  = []byte("FeeRecipient")
 )
 
 type Params struct {
 	LockedTimeOut time.Duration `json:"locked_time_out"`
 	LockedTimeIn  time.Duration `json:"locked_time_in"`
+	// ChainFees contains swap fees taken on initialize, per destination chain
+	ChainFees []ChainFee `json:"chain_fees"`
+	// FeeRecipient receives collected swap fees, fee collector is used when empty
+	FeeRecipient string `json:"fee_recipient"`
 }
 
-func NewParams(lockedTimeOut, lockedTimeIn time.Duration) Params {
+func NewParams(lockedTimeOut, lockedTimeIn time.Duration, chainFees []ChainFee, feeRecipient string) Params {
 	return Params{
 		LockedTimeOut: lockedTimeOut,
 		LockedTimeIn:  lockedTimeIn,
+		ChainFees:     chainFees,
+		FeeRecipient:  feeRecipient,
 	}
 }
 
@@ -40,6 +48,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyLockedTimeOut, &p.LockedTimeOut, validateLockedTime),
 		params.NewParamSetPair(KeyLockedTimeIn, &p.LockedTimeIn, validateLockedTime),
+		params.NewParamSetPair(KeyChainFees, &p.ChainFees, validateChainFees),
+		params.NewParamSetPair(KeyFeeRecipient, &p.FeeRecipient, validateFeeRecipient),
 	}
 }
 
@@ -56,10 +66,51 @@ func validateLockedTime(i interface{}) error {
 	return nil
 }
 
+func validateChainFees(i interface{}) error {
+	v, ok := i.([]ChainFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	chains := make(map[int]bool)
+	for _, fee := range v {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+		if chains[fee.ChainNumber] {
+			return fmt.Errorf("duplicate fee for chain %d", fee.ChainNumber)
+		}
+		chains[fee.ChainNumber] = true
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(v)
+	if err != nil {
+		return fmt.Errorf("invalid fee recipient: %w", err)
+	}
+
+	return nil
+}
+
 func DefaultParams() Params {
-	return NewParams(DefaultLockedTimeOut, DefaultLockedTimeIn)
+	return NewParams(DefaultLockedTimeOut, DefaultLockedTimeIn, []ChainFee{}, "")
 }
 
 func (p Params) Validate() error {
-	return nil
+	if err := validateChainFees(p.ChainFees); err != nil {
+		return err
+	}
+	return validateFeeRecipient(p.FeeRecipient)
 }
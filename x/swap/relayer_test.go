@@ -0,0 +1,50 @@
+package swap
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"bitbucket.org/decimalteam/go-node/x/swap/client/relayer"
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/keeper"
+	"bitbucket.org/decimalteam/go-node/x/swap/internal/types"
+)
+
+func TestSwapInitializeRelayRoundTrip(t *testing.T) {
+	ctx, k, accountKeeper, supplyKeeper := keeper.CreateTestInput(t, false, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(100000))))
+
+	k.SetChain(ctx, 1, types.NewChain("decimal", true))
+	k.SetChain(ctx, 2, types.NewChain("ethereum", true))
+
+	params := k.GetParams(ctx)
+	params.ChainFees = []types.ChainFee{types.NewChainFee(2, sdk.NewDecWithPrec(1, 2), sdk.NewInt(5))}
+	k.SetParams(ctx, params)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := relayer.NewSigner(key)
+	chain := relayer.NewMockChain(signer.CheckingAddress())
+	r := relayer.NewRelayer(signer, chain, nil, 2, log.NewNopLogger())
+
+	sender, recipient := keeper.Addrs[0], keeper.Addrs[1]
+	msg := types.NewMsgSwapInitialize(sender, recipient.String(), sdk.NewInt(1000), "del", "1", 1, 2)
+	res, err := NewHandler(k)(ctx, msg)
+	require.NoError(t, err)
+
+	require.NoError(t, r.HandleEvents(res.Events.ToABCIEvents()))
+
+	// recipient receives the amount without fee on the counter chain
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(990))), chain.Balance(recipient))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(99000))), accountKeeper.GetAccount(ctx, sender).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(990))), k.GetLockedFunds(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("del", sdk.NewInt(10))), supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+
+	// amount which doesn't cover minimal fee is rejected
+	msg = types.NewMsgSwapInitialize(sender, recipient.String(), sdk.NewInt(5), "del", "2", 1, 2)
+	_, err = NewHandler(k)(ctx, msg)
+	require.Error(t, err)
+}
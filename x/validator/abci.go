@@ -114,6 +114,17 @@ func EndBlocker(ctx sdk.Context, k Keeper, coinKeeper coin.Keeper, supplyKeeper
 	}
 	coinKeeper.UpdateCoin(ctx, denomCoin, denomCoin.Reserve, denomCoin.Volume.Add(rewards))
 
+	// the treasury receives its share before the rewards are distributed between the validators,
+	// the commission is zero until it is set by the params
+	treasuryReward := rewards.ToDec().Mul(k.TreasuryCommission(ctx)).TruncateInt()
+	if treasuryReward.IsPositive() {
+		err = k.PayTreasury(ctx, treasuryReward)
+		if err != nil {
+			panic(err)
+		}
+		rewards = rewards.Sub(treasuryReward)
+	}
+
 	remainder := sdk.NewIntFromBigInt(rewards.BigInt())
 
 	vals := k.GetAllValidatorsByPowerIndex(ctx)
@@ -17,6 +17,7 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	NotBondedPoolName = types.NotBondedPoolName
 	BondedPoolName    = types.BondedPoolName
+	TreasuryPoolName  = types.TreasuryPoolName
 	DefaultBondDenom  = types.DefaultBondDenom
 
 	ValidatorsKey = types.ValidatorsKey
@@ -951,6 +951,8 @@ func TestUnbondNFT(t *testing.T) {
 	nftHandler := nft.GenericHandler(nftKeeper)
 	_, err = nftHandler(ctx, nft.NewMsgMintNFT(delegatorAddr, delegatorAddr, tokenID, denom, "", quantity, reserve, true))
 	require.NoError(t, err)
+	nftKeeper.MigrateNFTStore(ctx)
+	require.NoError(t, nftKeeper.ApproveSubTokens(ctx, delegatorAddr, denom, tokenID, []int64{1}, val.Addrs[1]))
 
 	// delegate nft
 	msgDelegateNft := types.NewMsgDelegateNFT(validatorAddr, delegatorAddr, tokenID, denom, []int64{1, 2, 3})
@@ -958,6 +960,10 @@ func TestUnbondNFT(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
+	// the approvals of the delegated sub-tokens are cleared
+	_, found := nftKeeper.GetSubTokenApproval(ctx, denom, tokenID, 1)
+	require.False(t, found)
+
 	// unbond the half of delegations nft
 	unbondQuantity := sdk.NewInt(2)
 	msgUnbondNFT := types.NewMsgUnbondNFT(validatorAddr, delegatorAddr, tokenID, denom, []int64{1, 2})
@@ -1106,9 +1112,9 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64, addrList []s
 		Volume: coinConfig.InitialVolumeBaseCoin,
 	})
 
-	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bk)
+	multisigKeeper := multisig.NewKeeper(cdc, keyMultisig, pk.Subspace(multisig.DefaultParamspace), accountKeeper, coinKeeper, bk, nil)
 
-	nftKeeper := nft.NewKeeper(cdc, keyCoin, supplyKeeper, types.DefaultBondDenom)
+	nftKeeper := nft.NewKeeper(cdc, keyCoin, supplyKeeper, bk, types.DefaultBondDenom)
 
 	keeper := NewKeeper(cdc, keyStaking, pk.Subspace(val.DefaultParamspace), coinKeeper, accountKeeper, supplyKeeper, multisigKeeper, nftKeeper, auth.FeeCollectorName)
 	keeper.SetParams(ctx, types.DefaultParams())
@@ -780,12 +780,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 					}
 
 				case types.UnbondingDelegationNFTEntry:
-					collection, ok := k.nftKeeper.GetCollection(ctx, entry.Denom)
-					if !ok {
-						return fmt.Errorf("collection not found")
-					}
-
-					token, err := collection.GetNFT(entry.TokenID)
+					token, err := k.nftKeeper.GetNFT(ctx, entry.Denom, entry.TokenID)
 					if err != nil {
 						return err
 					}
@@ -803,12 +798,10 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 
 					token = token.SetOwners(token.GetOwners().SetOwner(owner))
 
-					collection, err = collection.UpdateNFT(token)
+					err = k.nftKeeper.UpdateNFT(ctx, entry.Denom, token)
 					if err != nil {
 						return err
 					}
-
-					k.nftKeeper.SetCollection(ctx, entry.Denom, collection)
 				default:
 					panic(fmt.Sprintf("%T", entry))
 				}
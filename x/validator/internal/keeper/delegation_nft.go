@@ -72,6 +72,11 @@ func (k Keeper) DelegateNFT(ctx sdk.Context, delAddr sdk.AccAddress, tokenID, de
 		return fmt.Errorf("not found owner %s", delAddr.String())
 	}
 
+	err = nftTypes.CheckTransferable(nft, denom, delAddr, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
 	for _, id := range subTokenIDs {
 		if nftTypes.SortedIntArray(owner.GetSubTokenIDs()).Find(id) == -1 {
 			return fmt.Errorf("the owner %s does not own the token with ID = %d", owner.GetAddress().String(), id)
@@ -81,17 +86,15 @@ func (k Keeper) DelegateNFT(ctx sdk.Context, delAddr sdk.AccAddress, tokenID, de
 
 	nft = nft.SetOwners(nft.GetOwners().SetOwner(owner))
 
-	collection, ok := k.nftKeeper.GetCollection(ctx, denom)
-	if !ok {
-		return fmt.Errorf("collection %s not found", collection)
-	}
-
-	collection, err = collection.UpdateNFT(nft)
+	err = k.nftKeeper.UpdateNFT(ctx, denom, nft)
 	if err != nil {
 		return err
 	}
 
-	k.nftKeeper.SetCollection(ctx, denom, collection)
+	// the delegated sub-tokens leave the owner as transferred ones do, so their approvals are cleared
+	if k.nftKeeper.IsNFTStoreMigrated(ctx) {
+		k.nftKeeper.ClearSubTokenApprovals(ctx, denom, tokenID, subTokenIDs)
+	}
 
 	delegation, found := k.GetDelegationNFT(ctx, validator.ValAddress, delAddr, tokenID, denom)
 	if !found {
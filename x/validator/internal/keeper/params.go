@@ -15,7 +15,7 @@ const (
 
 // ParamTable for staking module
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable().RegisterParamSet(&types.Params{})
+	return params.NewKeyTable().RegisterParamSet(&types.Params{}).RegisterType(types.TreasuryCommissionParamSetPair())
 }
 
 // UnbondingTime
@@ -56,6 +56,16 @@ func (k Keeper) MaxDelegations(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// TreasuryCommission = share of the block rewards and fees sent to the treasury,
+// zero if it is not set
+func (k Keeper) TreasuryCommission(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.GetIfExists(ctx, types.KeyTreasuryCommission, &res)
+	if res.IsNil() {
+		res = sdk.ZeroDec()
+	}
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -65,10 +75,14 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MaxDelegations(ctx),
+		k.TreasuryCommission(ctx),
 	)
 }
 
 // set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
+	if !params.TreasuryCommission.IsNil() && !params.TreasuryCommission.IsZero() {
+		k.paramSpace.Set(ctx, types.KeyTreasuryCommission, params.TreasuryCommission)
+	}
 }
@@ -14,6 +14,28 @@ import (
 var DAOCommission = sdk.NewDec(5).QuoInt64(100)
 var DevelopCommission = sdk.NewDec(5).QuoInt64(100)
 
+// PayTreasury mints the share of the block rewards and fees of the treasury to its module account
+func (k Keeper) PayTreasury(ctx sdk.Context, amount sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount))
+	err := k.supplyKeeper.MintCoins(ctx, k.FeeCollectorName, coins)
+	if err != nil {
+		return err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.FeeCollectorName, types.TreasuryPoolName, coins)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTreasuryReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
 func (k Keeper) PayRewards(ctx sdk.Context) error {
 	validators := k.GetAllValidators(ctx)
 	delegations := k.GetAllDelegationsByValidator(ctx)
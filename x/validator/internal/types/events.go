@@ -20,6 +20,7 @@ const (
 	EventTypeCalcStake            = "calc_stake"
 	EventTypeDAOReward            = "dao_reward"
 	EventTypeDevelopReward        = "develop_reward"
+	EventTypeTreasuryReward       = "treasury_reward"
 
 	AttributeDelPrice                      = "del"
 	AttributeKeyValidator                  = "validator"
@@ -34,14 +34,19 @@ const (
 	DefaultMaxDelegations uint16 = 1000
 )
 
+// DefaultTreasuryCommission is the share of the block rewards and fees sent to the treasury,
+// nothing is sent until it is changed by governance
+var DefaultTreasuryCommission = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime     = []byte("UnbondingTime")
-	KeyMaxValidators     = []byte("MaxValidators")
-	KeyMaxEntries        = []byte("KeyMaxEntries")
-	KeyBondDenom         = []byte("BondDenom")
-	KeyHistoricalEntries = []byte("HistoricalEntries")
-	KeyMaxDelegations    = []byte("MaxDelegations")
+	KeyUnbondingTime      = []byte("UnbondingTime")
+	KeyMaxValidators      = []byte("MaxValidators")
+	KeyMaxEntries         = []byte("KeyMaxEntries")
+	KeyBondDenom          = []byte("BondDenom")
+	KeyHistoricalEntries  = []byte("HistoricalEntries")
+	KeyMaxDelegations     = []byte("MaxDelegations")
+	KeyTreasuryCommission = []byte("TreasuryCommission")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -55,11 +60,13 @@ type Params struct {
 	BondDenom         string `json:"bond_denom" yaml:"bond_denom"`                 // bondable coin denomination
 	HistoricalEntries uint16 `json:"historical_entries" yaml:"historical_entries"` // number of historical entries to persist
 	MaxDelegations    uint16 `json:"max_delegations" yaml:"max_delegations"`
+
+	TreasuryCommission sdk.Dec `json:"treasury_commission" yaml:"treasury_commission"` // share of the block rewards and fees sent to the treasury
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint16,
-	bondDenom string, maxDelegations uint16) Params {
+	bondDenom string, maxDelegations uint16, treasuryCommission sdk.Dec) Params {
 
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -68,6 +75,8 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		BondDenom:         bondDenom,
 		HistoricalEntries: historicalEntries,
 		MaxDelegations:    maxDelegations,
+
+		TreasuryCommission: treasuryCommission,
 	}
 }
 
@@ -83,6 +92,13 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	}
 }
 
+// TreasuryCommissionParamSetPair returns the key of the treasury commission. It is registered apart
+// from the other params to be stored only when it is set, so the genesis of the chains created
+// before the treasury results in the same state.
+func TreasuryCommissionParamSetPair() params.ParamSetPair {
+	return params.NewParamSetPair(KeyTreasuryCommission, sdk.Dec{}, validateTreasuryCommission)
+}
+
 // Equal returns a boolean determining if two Param types are identical.
 // TODO: This is slower than comparing struct fields directly
 func (p Params) Equal(p2 Params) bool {
@@ -93,7 +109,7 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, DefaultHistoricalEntries, DefaultBondDenom, DefaultMaxDelegations)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, DefaultHistoricalEntries, DefaultBondDenom, DefaultMaxDelegations, DefaultTreasuryCommission)
 }
 
 // String returns a human readable string representation of the parameters.
@@ -104,8 +120,9 @@ func (p Params) String() string {
   Max Entries:        %d
   Historical Entries: %d
   Bonded Coin Denom:  %s
-  Max Delegations:    %d`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom, p.MaxDelegations)
+  Max Delegations:    %d
+  Treasury Commission: %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom, p.MaxDelegations, p.TreasuryCommission)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -146,6 +163,9 @@ func (p Params) Validate() error {
 	if err := validateMaxDelegations(p.MaxDelegations); err != nil {
 		return err
 	}
+	if err := validateTreasuryCommission(p.TreasuryCommission); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -226,3 +246,23 @@ func validateMaxDelegations(i interface{}) error {
 
 	return nil
 }
+
+func validateTreasuryCommission(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the commission is not set in the genesis of the chains created before the treasury
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("treasury commission cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury commission too large: %s", v)
+	}
+
+	return nil
+}
@@ -11,9 +11,12 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - TreasuryPool -> "treasury"
 const (
 	NotBondedPoolName = "not_bonded_tokens_pool"
 	BondedPoolName    = "bonded_tokens_pool"
+	TreasuryPoolName  = "treasury"
 )
 
 // Pool - tracking bonded and not-bonded token supply of the bond denomination